@@ -1,6 +1,8 @@
 package cmd
 
 import (
+	"fmt"
+	"io"
 	"io/ioutil"
 	"path"
 
@@ -14,43 +16,77 @@ func init() {
 	var configFile string
 	var packageName string
 	var outputDir string
+	var dryRun bool
 
 	var generateCmd = &cobra.Command{
 		Use:   "generate",
 		Short: "Generate go code for a graphql schema",
 		Long:  "Generate go code for a graphql schema",
-		Run: func(cmd *cobra.Command, args []string) {
+		// Errors are reported by Execute, the usage would only hide them
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
 			var conf config.Config
-			conf.Package = packageName
 
 			if configFile != "" {
-				fileBytes, err := ioutil.ReadFile(configFile)
+				var err error
+				conf, err = config.Load(configFile)
 				if err != nil {
-					panic(err)
+					return fmt.Errorf("loading config %s: %v", configFile, err)
 				}
-				conf, err = config.Parse(string(fileBytes))
+			} else {
+				// Without -config the config is looked up from the working
+				// directory up to the module root
+				var err error
+				conf, err = config.Discover(".")
 				if err != nil {
-					panic(err)
+					return err
 				}
 			}
 
-			schemaBytes, err := ioutil.ReadFile(schemaFile)
+			conf.Package = generatedPackage(conf, packageName, cmd.Flags().Changed("package"))
+
+			// A schema piped to stdin is generated to stdout unless an output directory is given
+			fromStdin := schemaFile == "-"
+			toStdout := outputDir == "-" || (fromStdin && !cmd.Flags().Changed("output"))
+
+			var schemaBytes []byte
+			var err error
+			if fromStdin {
+				schemaBytes, err = ioutil.ReadAll(cmd.InOrStdin())
+			} else {
+				schemaBytes, err = ioutil.ReadFile(schemaFile)
+			}
 			if err != nil {
-				panic(err)
+				return fmt.Errorf("reading schema: %v", err)
+			}
+
+			if dryRun {
+				entries, err := codegen.GeneratePlan(string(schemaBytes), conf)
+				if err != nil {
+					if fromStdin {
+						return fmt.Errorf("stdin: %v", err)
+					}
+					return fmt.Errorf("%s: %v", schemaFile, err)
+				}
+				return writePlan(cmd.OutOrStdout(), entries)
 			}
 
 			cg := codegen.NewCodeGen(string(schemaBytes), conf)
+			if !fromStdin {
+				cg.SetSource(path.Base(schemaFile))
+			}
 			fileMap, err := cg.Generate()
 			if err != nil {
-				panic(err)
+				if fromStdin {
+					return fmt.Errorf("stdin: %v", err)
+				}
+				return fmt.Errorf("%s: %v", schemaFile, err)
 			}
 
-			for filename, fileContent := range fileMap {
-				err := ioutil.WriteFile(path.Join(outputDir, filename), []byte(fileContent), 0644)
-				if err != nil {
-					panic(err)
-				}
+			if toStdout {
+				return codegen.WriteTo(cmd.OutOrStdout(), fileMap)
 			}
+			return codegen.WriteFiles(fileMap, outputDir)
 		},
 	}
 
@@ -60,13 +96,48 @@ func init() {
 
 	// Cobra supports Persistent Flags which will work for this command
 	// and all subcommands, e.g.:
-	generateCmd.PersistentFlags().StringVarP(&schemaFile, "schema", "s", "graphql.schema", "graphql.schema file")
-	generateCmd.PersistentFlags().StringVarP(&configFile, "config", "c", "", "Optional configuration file. Default options are used if path is not defined")
+	generateCmd.PersistentFlags().StringVarP(&schemaFile, "schema", "s", "graphql.schema", "graphql.schema file, - reads the schema from stdin")
+	generateCmd.PersistentFlags().StringVarP(&configFile, "config", "c", "", "Optional configuration file. Without it codegen.hcl, codegen.yaml or codegen.json is looked up from the working directory up to the module root, default options are used if none is found")
 	generateCmd.PersistentFlags().StringVarP(&packageName, "package", "p", "main", "Package name for generated files")
-	generateCmd.PersistentFlags().StringVarP(&outputDir, "output", "o", ".", "Output directory. Defaults to current working directory")
+	generateCmd.PersistentFlags().StringVarP(&outputDir, "output", "o", ".", "Output directory. Defaults to current working directory, - prints the files to stdout")
+	generateCmd.PersistentFlags().BoolVar(&dryRun, "dry-run", false, "Print the files that would be generated without generating them")
 
 	// Cobra supports local flags which will only run when this command
 	// is called directly, e.g.:
 	// generateCmd.Flags().BoolP("toggle", "t", false, "Help message for toggle")
 
 }
+
+// generatedPackage returns the package of the generated files, the -p flag
+// when it is given, otherwise the package of the config or the flag's
+// default main
+func generatedPackage(conf config.Config, packageFlag string, changed bool) string {
+	if changed || conf.Package == "" {
+		return packageFlag
+	}
+	return conf.Package
+}
+
+// writePlan prints a line per planned file with the type generated into it.
+// Types needing a hand written implementation are marked.
+func writePlan(w io.Writer, entries []codegen.PlanEntry) error {
+	for _, entry := range entries {
+		line := fmt.Sprintf("%s\t%s %s", entry.FileName, entry.Kind, entry.TypeName)
+		if entry.Mock {
+			line = fmt.Sprintf("%s\tmock of %s %s", entry.FileName, entry.Kind, entry.TypeName)
+		}
+		if entry.Seed {
+			line = fmt.Sprintf("%s\tresolvers of %s %s, missing funcs appended", entry.FileName, entry.Kind, entry.TypeName)
+		}
+		if entry.Loaders {
+			line = fmt.Sprintf("%s\tloaders of %s %s, missing funcs appended", entry.FileName, entry.Kind, entry.TypeName)
+		}
+		if !entry.Supported {
+			line += " (placeholder, implement by hand)"
+		}
+		if _, err := fmt.Fprintln(w, line); err != nil {
+			return err
+		}
+	}
+	return nil
+}
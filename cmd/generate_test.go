@@ -0,0 +1,52 @@
+package cmd
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/Applifier/graphql-codegen/codegen"
+	"github.com/Applifier/graphql-codegen/config"
+)
+
+func TestGeneratedPackage(t *testing.T) {
+	for _, test := range []struct {
+		confPackage string
+		flag        string
+		changed     bool
+		expected    string
+	}{
+		{"", "main", false, "main"},
+		{"resolvers", "main", false, "resolvers"},
+		{"resolvers", "foo", true, "foo"},
+		{"", "foo", true, "foo"},
+		// An explicit -p main also wins over the config
+		{"resolvers", "main", true, "main"},
+	} {
+		if pkg := generatedPackage(config.Config{Package: test.confPackage}, test.flag, test.changed); pkg != test.expected {
+			t.Errorf("Expected package %s for config package %q and -p %s (given %t), got %s", test.expected, test.confPackage, test.flag, test.changed, pkg)
+		}
+	}
+}
+
+func TestWritePlan(t *testing.T) {
+	buf := &bytes.Buffer{}
+	err := writePlan(buf, []codegen.PlanEntry{
+		{TypeName: "User", Kind: "OBJECT", FileName: "user_gen.go", Supported: true},
+		{TypeName: "Node", Kind: "INTERFACE", FileName: "node_mock_gen.go", Mock: true, Supported: true},
+		{TypeName: "Query", Kind: "OBJECT", FileName: "query_resolvers.go", Seed: true, Supported: true},
+		{TypeName: "User", Kind: "OBJECT", FileName: "user_loaders.go", Loaders: true, Supported: true},
+		{TypeName: "Time", Kind: "SCALAR", FileName: "time_gen.go"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := "user_gen.go\tOBJECT User\n" +
+		"node_mock_gen.go\tmock of INTERFACE Node\n" +
+		"query_resolvers.go\tresolvers of OBJECT Query, missing funcs appended\n" +
+		"user_loaders.go\tloaders of OBJECT User, missing funcs appended\n" +
+		"time_gen.go\tSCALAR Time (placeholder, implement by hand)\n"
+	if buf.String() != expected {
+		t.Errorf("Expected the plan\n%s\ngot\n%s", expected, buf.String())
+	}
+}
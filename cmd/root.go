@@ -23,14 +23,16 @@ to quickly create a Cobra application.`,
 	// Uncomment the following line if your bare application
 	// has an action associated with it:
 	//	Run: func(cmd *cobra.Command, args []string) { },
+	// Execute prints the errors, once
+	SilenceErrors: true,
 }
 
 // Execute adds all child commands to the root command sets flags appropriately.
 // This is called by main.main(). It only needs to happen once to the rootCmd.
 func Execute() {
 	if err := RootCmd.Execute(); err != nil {
-		fmt.Println(err)
-		os.Exit(-1)
+		fmt.Fprintln(os.Stderr, "graphql-codegen:", err)
+		os.Exit(1)
 	}
 }
 
@@ -57,8 +59,9 @@ func initConfig() {
 	viper.AddConfigPath("$HOME")            // adding home directory as first search path
 	viper.AutomaticEnv()                    // read in environment variables that match
 
-	// If a config file is found, read it in.
+	// If a config file is found, read it in. Stdout is kept for the
+	// generated code of -o -
 	if err := viper.ReadInConfig(); err == nil {
-		fmt.Println("Using config file:", viper.ConfigFileUsed())
+		fmt.Fprintln(os.Stderr, "Using config file:", viper.ConfigFileUsed())
 	}
 }
@@ -1,3 +1,5 @@
 package cmd
 
-const VERSION = "1.0.0"
+import "github.com/Applifier/graphql-codegen/codegen"
+
+const VERSION = codegen.Version
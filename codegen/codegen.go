@@ -3,9 +3,21 @@ package codegen
 import (
 	"bytes"
 	"fmt"
+	"go/parser"
+	"go/token"
+	"io"
+	"io/ioutil"
 	"log"
+	"path"
+	"reflect"
+	"runtime"
+	"sort"
+	"strconv"
 	"strings"
+	"sync"
 	"text/template"
+	"unicode"
+	"unicode/utf8"
 
 	"github.com/Applifier/graphql-codegen/config"
 	codegenTemplate "github.com/Applifier/graphql-codegen/template"
@@ -55,27 +67,395 @@ var (
 )
 
 type CodeGen struct {
-	graphSchema  string
-	conf         config.Config
-	mutationName string
-	queryName    string
+	graphSchema      string
+	conf             config.Config
+	mutationName     string
+	queryName        string
+	subscriptionName string
+	source           string
+	rootPackage      string
+	// unions maps the name of an object type to the unions it is a member of
+	unions map[string][]string
+	// templates holds the templates of parseTemplate bound to this CodeGen
+	templates sync.Map
 }
 
 func NewCodeGen(graphSchema string, conf config.Config) *CodeGen {
-	return &CodeGen{graphSchema, conf, "", ""}
+	return &CodeGen{graphSchema: graphSchema, conf: conf}
+}
+
+// SetSource sets the schema source name mentioned in the generated file headers
+func (g *CodeGen) SetSource(source string) {
+	g.source = source
+}
+
+// PlanEntry is a file Generate would write
+type PlanEntry struct {
+	// TypeName is the schema name of the type, Resolver for the entry point or
+	// Schema for the schema constant
+	TypeName string
+	// Kind is the introspection kind of the type, RESOLVER for the entry point
+	// or SCHEMA for the schema constant
+	Kind string
+	// FileName is the path of the file relative to the output directory
+	FileName string
+	// Mock is set for the mock of an interface type
+	Mock bool
+	// Seed is set for the file seeded with the resolvers of an entry point,
+	// written only when it does not exist yet
+	Seed bool
+	// Loaders is set for the file seeded with the batch functions of the
+	// dataloaders of a type, written only when it does not exist yet
+	Loaders bool
+	// Supported is false for types generated as a placeholder to be
+	// implemented by hand, such as custom scalars without a scalar config
+	Supported bool
+}
+
+// GeneratePlan reports the files Generate would write for the schema without
+// generating them. The config is validated like by Generate.
+func GeneratePlan(graphSchema string, conf config.Config) ([]PlanEntry, error) {
+	_, _, entries, err := NewCodeGen(graphSchema, conf).plan()
+	return entries, err
+}
+
+// GenerateFunc generates code for the schema like Generate, handing each file
+// to emit as soon as it is generated instead of collecting them. An error
+// returned by emit stops the generation and is returned.
+func GenerateFunc(graphSchema string, conf config.Config, emit func(fileName string, r io.Reader) error) error {
+	return NewCodeGen(graphSchema, conf).GenerateFunc(emit)
 }
 
 func (g *CodeGen) Generate() (map[string]string, error) {
+	results := map[string]string{}
+	err := g.GenerateFunc(func(fileName string, r io.Reader) error {
+		code, err := ioutil.ReadAll(r)
+		if err != nil {
+			return err
+		}
+		results[fileName] = string(code)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+// GenerateFunc hands each generated file to emit, in the order of the plan
+func (g *CodeGen) GenerateFunc(emit func(fileName string, r io.Reader) error) error {
+	return g.generateFiles(nil, g.postProcess(emit))
+}
+
+// postProcess wraps emit to hand the files to the PostProcess hook of the
+// config first
+func (g *CodeGen) postProcess(emit func(fileName string, r io.Reader) error) func(fileName string, r io.Reader) error {
+	if g.conf.PostProcess == nil {
+		return emit
+	}
+	return func(fileName string, r io.Reader) error {
+		code, err := ioutil.ReadAll(r)
+		if err != nil {
+			return err
+		}
+		code, err = g.conf.PostProcess(fileName, code)
+		if err != nil {
+			return fmt.Errorf("post processing %s: %v", fileName, err)
+		}
+		return emit(fileName, bytes.NewReader(code))
+	}
+}
+
+// generateFiles hands the files of the plan to emit. With selectTypes only
+// the files of the types it selects from the planned types are generated,
+// leaving out the entry point and the schema constant.
+func (g *CodeGen) generateFiles(selectTypes func(types []*introspection.Type) (map[string]bool, error), emit func(fileName string, r io.Reader) error) error {
+	conf, types, entries, err := g.plan()
+	if err != nil {
+		return err
+	}
+
+	if selectTypes != nil {
+		selected, err := selectTypes(types)
+		if err != nil {
+			return err
+		}
+		var selectedTypes []*introspection.Type
+		for _, qlType := range types {
+			if selected[*qlType.Name()] {
+				selectedTypes = append(selectedTypes, qlType)
+			}
+		}
+		var selectedEntries []PlanEntry
+		for _, entry := range entries {
+			if entry.Kind != "RESOLVER" && entry.Kind != "SCHEMA" && selected[entry.TypeName] {
+				selectedEntries = append(selectedEntries, entry)
+			}
+		}
+		types, entries = selectedTypes, selectedEntries
+	}
+
+	typeIndexes := map[string]int{}
+	for i, qlType := range types {
+		typeIndexes[*qlType.Name()] = i
+	}
+
+	// The files of each type, the type itself and its mock, and the files
+	// generated after the types
+	typeEntries := make([][]PlanEntry, len(types))
+	var rest []PlanEntry
+	for _, entry := range entries {
+		if entry.Kind == "RESOLVER" || entry.Kind == "SCHEMA" {
+			rest = append(rest, entry)
+			continue
+		}
+		i := typeIndexes[entry.TypeName]
+		typeEntries[i] = append(typeEntries[i], entry)
+	}
+
+	// Templates render the code after the package clause of the header, which
+	// is checked against the package of the directory the file is written to
+	emit = checkPackages(emit, entries, g.rootPackage, func(name string) string {
+		pkg, _ := g.typePackage(name, conf)
+		return pkg
+	})
+
+	err = g.generateTypes(types, conf, func(i int, code string) error {
+		for _, entry := range typeEntries[i] {
+			if entry.Mock || entry.Seed || entry.Loaders {
+				var extra string
+				var err error
+				switch {
+				case entry.Mock:
+					extra, err = g.generateMock(types[i], conf)
+				case entry.Seed:
+					extra, err = g.generateSeed(types[i], conf)
+				default:
+					extra, err = g.generateLoaders(types[i], conf)
+				}
+				if err != nil {
+					return err
+				}
+				if err := emit(entry.FileName, strings.NewReader(extra)); err != nil {
+					return err
+				}
+				continue
+			}
+			if err := emit(entry.FileName, strings.NewReader(code)); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, entry := range rest {
+		var code string
+		if entry.Kind == "RESOLVER" {
+			code, err = g.generateEntryPoint(types, conf)
+		} else {
+			code, err = g.generateSchema(conf)
+		}
+		if err != nil {
+			return err
+		}
+		if err := emit(entry.FileName, strings.NewReader(code)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkPackages wraps emit to return an error for a file whose package clause
+// does not name the package of its directory, given by the types of entries
+// in it with typePackage. Files of the entry point and the schema are in
+// rootPackage.
+func checkPackages(emit func(fileName string, r io.Reader) error, entries []PlanEntry, rootPackage string, typePackage func(name string) string) func(fileName string, r io.Reader) error {
+	packages := map[string]string{}
+	for _, entry := range entries {
+		pkg := rootPackage
+		if entry.Kind != "RESOLVER" && entry.Kind != "SCHEMA" {
+			pkg = typePackage(entry.TypeName)
+		}
+		packages[entry.FileName] = pkg
+	}
+
+	return func(fileName string, r io.Reader) error {
+		code, err := ioutil.ReadAll(r)
+		if err != nil {
+			return err
+		}
+		f, err := parser.ParseFile(token.NewFileSet(), fileName, code, parser.PackageClauseOnly)
+		if err != nil {
+			return fmt.Errorf("%s: generated code does not parse: %v", fileName, err)
+		}
+		if pkg, ok := packages[fileName]; ok && f.Name.Name != pkg {
+			return fmt.Errorf("%s is generated as package %s, the other files of %s are package %s", fileName, f.Name.Name, path.Dir(fileName), pkg)
+		}
+		return emit(fileName, bytes.NewReader(code))
+	}
+}
+
+// plan validates the config, parses the schema and returns the types to
+// generate and the files they are generated into. The returned config has the
+// defaults filled in.
+func (g *CodeGen) plan() (config.Config, []*introspection.Type, []PlanEntry, error) {
 	graphSchema := g.graphSchema
 	conf := g.conf
 
+	if conf.Package == "" {
+		conf.Package = "main"
+	}
+	if !token.IsIdentifier(conf.Package) || conf.Package == "_" {
+		return conf, nil, nil, fmt.Errorf("invalid package name %q", conf.Package)
+	}
+	g.rootPackage = conf.Package
+
+	switch conf.Mode {
+	case "", "server", "client", "models":
+	default:
+		return conf, nil, nil, fmt.Errorf("unknown mode %q, expected server, client or models", conf.Mode)
+	}
+
+	// gqlgen binds models, so the gqlgen target generates them
+	switch conf.Target {
+	case "":
+	case "gqlgen":
+		if conf.Mode != "" && conf.Mode != "models" {
+			return conf, nil, nil, fmt.Errorf("target gqlgen generates models, not %s", conf.Mode)
+		}
+		conf.Mode = "models"
+	default:
+		return conf, nil, nil, fmt.Errorf("unknown target %q, expected gqlgen", conf.Target)
+	}
+
+	typeNames := make([]string, 0, len(conf.Type))
+	for name := range conf.Type {
+		typeNames = append(typeNames, name)
+	}
+	sort.Strings(typeNames)
+	// The files of a directory are one package, so the types generated into
+	// a directory have to agree on its name
+	dirPackages := map[string]string{}
+	dirTypes := map[string]string{}
+	for _, name := range typeNames {
+		if pkg, dir := g.typePackage(name, conf); dir != "" {
+			if !token.IsIdentifier(pkg) || pkg == "_" {
+				return conf, nil, nil, fmt.Errorf("invalid package name %q for type %s", pkg, name)
+			}
+			if conf.ImportPath == "" {
+				return conf, nil, nil, fmt.Errorf("import_path is required to generate type %s into package %s", name, pkg)
+			}
+			if other, ok := dirPackages[dir]; ok && other != pkg {
+				return conf, nil, nil, fmt.Errorf("types %s and %s are generated into %s as packages %s and %s", dirTypes[dir], name, dir, other, pkg)
+			}
+			dirPackages[dir] = pkg
+			dirTypes[dir] = name
+		}
+	}
+
+	if err := g.checkTemplateFuncs(conf.TemplateFuncs); err != nil {
+		return conf, nil, nil, err
+	}
+
+	if conf.RootInterfaces {
+		switch {
+		case g.generatesStructs():
+			return conf, nil, nil, fmt.Errorf("root_interfaces needs the resolvers mode, the %s mode has no resolvers", conf.Mode)
+		case conf.SeedResolvers:
+			return conf, nil, nil, fmt.Errorf("root_interfaces cannot be combined with seed_resolvers, the root types have no resolvers to seed")
+		case conf.GenerateRegistry:
+			return conf, nil, nil, fmt.Errorf("root_interfaces cannot be combined with generate_registry, the RootResolver is set up with the resolvers of the roots")
+		}
+	}
+
+	if err := g.checkEmbedStruct(conf); err != nil {
+		return conf, nil, nil, err
+	}
+
+	if conf.TypePrefix != "" && (!token.IsIdentifier(conf.TypePrefix) || !token.IsExported(conf.TypePrefix)) {
+		return conf, nil, nil, fmt.Errorf("invalid type_prefix %q, expected the start of an exported Go name", conf.TypePrefix)
+	}
+
+	switch conf.OmitEmpty {
+	case "", "nullable", "always", "never":
+	default:
+		return conf, nil, nil, fmt.Errorf("unknown omit_empty %q, expected nullable, always or never", conf.OmitEmpty)
+	}
+
+	if _, err := g.fileNameTemplate(conf.FileNaming); err != nil {
+		return conf, nil, nil, err
+	}
+
+	for _, pattern := range conf.Ignore {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return conf, nil, nil, fmt.Errorf("invalid ignore pattern %q: %v", pattern, err)
+		}
+	}
+
+	// Scalars mapped with a directive take precedence over the scalar config
+	graphSchema, directiveScalars, err := config.GoTypeDirectives(graphSchema)
+	if err != nil {
+		return conf, nil, nil, err
+	}
+	// gqlgen maps IDs to strings
+	_, mapsID := conf.Scalar["ID"]
+	_, directiveID := directiveScalars["ID"]
+	gqlgenID := conf.Target == "gqlgen" && !mapsID && !directiveID
+	if len(directiveScalars) > 0 || gqlgenID {
+		scalars := map[string]config.ScalarConfig{}
+		for name, scalar := range conf.Scalar {
+			scalars[name] = scalar
+		}
+		for name, scalar := range directiveScalars {
+			scalars[name] = scalar
+		}
+		if gqlgenID {
+			scalars["ID"] = config.ScalarConfig{GoType: "string"}
+		}
+		conf.Scalar = scalars
+	}
+
+	scalarNames := make([]string, 0, len(conf.Scalar))
+	for name := range conf.Scalar {
+		scalarNames = append(scalarNames, name)
+	}
+	sort.Strings(scalarNames)
+	for _, name := range scalarNames {
+		if _, builtin := internalTypeConfig[name]; builtin && conf.Scalar[name].Assert {
+			return conf, nil, nil, fmt.Errorf("assert is only supported on custom scalars, graphql-go decodes the built-in scalar %s itself", name)
+		}
+		if conf.Scalar[name].Assert && g.generatesStructs() {
+			return conf, nil, nil, fmt.Errorf("assert on scalar %s needs the resolvers mode, the %s mode has no Resolver to generate the assertions with", name, conf.Mode)
+		}
+		if scalar := conf.Scalar[name]; scalar.Alias != "" {
+			if !token.IsIdentifier(scalar.Alias) || scalar.Alias == "_" {
+				return conf, nil, nil, fmt.Errorf("invalid alias %q for scalar %s", scalar.Alias, name)
+			}
+			if scalar.ImportPath == "" || !strings.Contains(scalar.GoType, ".") {
+				return conf, nil, nil, fmt.Errorf("the alias of scalar %s needs an import_path and a go_type qualified with the package name", name)
+			}
+		}
+	}
+
 	sch, err := graphql.ParseSchema(graphSchema, nil)
 	if err != nil {
-		return nil, err
+		return conf, nil, nil, err
 	}
 
 	ins := sch.Inspect()
 
+	g.unions = map[string][]string{}
+	for _, tp := range ins.Types() {
+		if tp.Kind() == "UNION" && tp.PossibleTypes() != nil {
+			for _, member := range *tp.PossibleTypes() {
+				g.unions[*member.Name()] = append(g.unions[*member.Name()], *tp.Name())
+			}
+		}
+	}
+
 	if ins.MutationType() != nil {
 		g.mutationName = g.returnString(ins.MutationType().Name())
 	}
@@ -84,47 +464,267 @@ func (g *CodeGen) Generate() (map[string]string, error) {
 		g.queryName = g.returnString(ins.QueryType().Name())
 	}
 
-	results := map[string]string{}
+	if ins.SubscriptionType() != nil {
+		g.subscriptionName = g.returnString(ins.SubscriptionType().Name())
+	}
+
+	for _, name := range typeNames {
+		if conf.Type[name].UnwrapInput && !g.isEntryPoint(name) {
+			return conf, nil, nil, fmt.Errorf("unwrap_input is only supported on the query, mutation and subscription types, not %s", name)
+		}
+	}
 
 	var entryPoint = false
 
-	for _, qlType := range ins.Types() {
+	qlTypes := ins.Types()
+	sort.Slice(qlTypes, func(i, j int) bool {
+		return *qlTypes[i].Name() < *qlTypes[j].Name()
+	})
+
+	types := []*introspection.Type{}
+	for _, qlType := range qlTypes {
 		name := *qlType.Name()
-		if strings.HasPrefix(name, "_") {
+		// The introspection types are generated on request, other names
+		// starting with _ are reserved
+		if strings.HasPrefix(name, "_") && !(conf.IncludeMetaTypes && strings.HasPrefix(name, "__")) {
 			continue
 		}
 
-		if internalTypeConfig[name].ignore {
+		if val, ok := g.getTypeConfig(name, conf); ok && val.ignore {
+			continue
+		}
+
+		if g.isIgnored(name, conf) {
 			continue
 		}
 
 		if g.isEntryPoint(name) {
+			// Models are the data types only
+			if conf.Mode == "models" {
+				continue
+			}
 			entryPoint = true
 		}
 
-		fileName := fmt.Sprintf("%s_gen.go", strings.ToLower(name))
+		types = append(types, qlType)
+	}
+
+	if err := g.checkGoNames(types); err != nil {
+		return conf, nil, nil, err
+	}
+	if err := g.checkImportCycles(types, conf); err != nil {
+		return conf, nil, nil, err
+	}
+	if err := g.checkEmbedPackage(types, conf); err != nil {
+		return conf, nil, nil, err
+	}
 
-		log.Printf("Generating Go code for %s %s", qlType.Kind(), name)
+	entries := []PlanEntry{}
+	// The types generated into each file, to detect types overwriting each other
+	fileTypes := map[string][]string{}
 
-		var code string
-		code, err = g.generateType(qlType, conf)
+	for _, qlType := range types {
+		_, dir := g.typePackage(*qlType.Name(), conf)
+		goName := g.goName(*qlType.Name())
+		fileName, err := g.fileName(goName, qlType.Kind(), "_gen.go")
 		if err != nil {
-			return nil, err
+			return conf, nil, nil, err
+		}
+		// Custom scalars get a placeholder resolver unless they use a template of their own
+		supported := qlType.Kind() != "SCALAR" || g.generatesStructs() || len(conf.Type[*qlType.Name()].Template) > 0
+		entries = append(entries, PlanEntry{TypeName: *qlType.Name(), Kind: qlType.Kind(), FileName: path.Join(dir, fileName), Supported: supported})
+		fileTypes[path.Join(dir, fileName)] = append(fileTypes[path.Join(dir, fileName)], *qlType.Name())
+
+		if conf.Mocks && !g.generatesStructs() && qlType.Kind() == "INTERFACE" {
+			mockFileName, err := g.fileName(goName, qlType.Kind(), "_mock_gen.go")
+			if err != nil {
+				return conf, nil, nil, err
+			}
+			entries = append(entries, PlanEntry{TypeName: *qlType.Name(), Kind: qlType.Kind(), FileName: path.Join(dir, mockFileName), Mock: true, Supported: true})
+			fileTypes[path.Join(dir, mockFileName)] = append(fileTypes[path.Join(dir, mockFileName)], "mock of "+*qlType.Name())
+		}
+
+		if g.seedsResolvers(*qlType.Name(), conf) {
+			seedFileName, err := g.fileName(goName, qlType.Kind(), "_resolvers.go")
+			if err != nil {
+				return conf, nil, nil, err
+			}
+			entries = append(entries, PlanEntry{TypeName: *qlType.Name(), Kind: qlType.Kind(), FileName: path.Join(dir, seedFileName), Seed: true, Supported: true})
+			fileTypes[path.Join(dir, seedFileName)] = append(fileTypes[path.Join(dir, seedFileName)], "resolvers of "+*qlType.Name())
 		}
 
-		results[fileName] = code
+		if g.loadsFields(*qlType.Name(), conf) {
+			loadersFileName, err := g.fileName(goName, qlType.Kind(), "_loaders.go")
+			if err != nil {
+				return conf, nil, nil, err
+			}
+			entries = append(entries, PlanEntry{TypeName: *qlType.Name(), Kind: qlType.Kind(), FileName: path.Join(dir, loadersFileName), Loaders: true, Supported: true})
+			fileTypes[path.Join(dir, loadersFileName)] = append(fileTypes[path.Join(dir, loadersFileName)], "loaders of "+*qlType.Name())
+		}
 	}
 
-	// Generate entry point
-	if entryPoint {
-		entry, err := g.generateEntryPoint(conf)
+	// Generate entry point, clients and models have no resolvers
+	if entryPoint && !g.generatesStructs() {
+		fileName, err := g.fileName(g.rootResolver(), "RESOLVER", "_gen.go")
 		if err != nil {
-			return nil, err
+			return conf, nil, nil, err
 		}
-		results["resolver_gen.go"] = entry
+		entries = append(entries, PlanEntry{TypeName: "Resolver", Kind: "RESOLVER", FileName: fileName, Supported: true})
+		fileTypes[fileName] = append(fileTypes[fileName], "entry point "+g.rootResolver())
 	}
 
-	return results, nil
+	if conf.GenerateSchema {
+		fileName, err := g.fileName(g.schemaName(), "SCHEMA", "_gen.go")
+		if err != nil {
+			return conf, nil, nil, err
+		}
+		entries = append(entries, PlanEntry{TypeName: "Schema", Kind: "SCHEMA", FileName: fileName, Supported: true})
+		fileTypes[fileName] = append(fileTypes[fileName], "Schema constant")
+	}
+
+	if err := checkFileCollisions(fileTypes); err != nil {
+		return conf, nil, nil, err
+	}
+
+	if conf.StrictUnsupported {
+		var unsupported []string
+		for _, entry := range entries {
+			if !entry.Supported {
+				unsupported = append(unsupported, entry.TypeName)
+			}
+		}
+		if len(unsupported) > 0 {
+			return conf, nil, nil, fmt.Errorf("unsupported types %s would need a hand written implementation", strings.Join(unsupported, ", "))
+		}
+	}
+
+	return conf, types, entries, nil
+}
+
+// checkFileCollisions returns an error listing the types generated into the
+// same file, where one would overwrite the other
+func checkFileCollisions(fileTypes map[string][]string) error {
+	fileNames := make([]string, 0, len(fileTypes))
+	for fileName := range fileTypes {
+		fileNames = append(fileNames, fileName)
+	}
+	sort.Strings(fileNames)
+
+	var collisions []string
+	for _, fileName := range fileNames {
+		if types := fileTypes[fileName]; len(types) > 1 {
+			collisions = append(collisions, fmt.Sprintf("%s are all generated into %s", strings.Join(types, ", "), fileName))
+		}
+	}
+	if len(collisions) > 0 {
+		return fmt.Errorf("file name collision: %s", strings.Join(collisions, "; "))
+	}
+	return nil
+}
+
+// generateTypes generates the code for types on a pool of conf.Workers
+// goroutines and hands it to emit in the order of types. The first error stops
+// handing out the remaining types, the error of the first failing type in
+// order is returned.
+func (g *CodeGen) generateTypes(types []*introspection.Type, conf config.Config, emit func(i int, code string) error) error {
+	workers := conf.Workers
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+
+	type result struct {
+		i    int
+		code string
+		err  error
+	}
+
+	indexes := make(chan int)
+	results := make(chan result)
+	// failed stops handing out types, done stops the workers
+	failed := make(chan struct{})
+	done := make(chan struct{})
+	var failOnce sync.Once
+	var wg sync.WaitGroup
+
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				g.logf("Generating Go code for %s %s", types[i].Kind(), *types[i].Name())
+				code, err := g.generateType(types[i], conf)
+				select {
+				case results <- result{i, code, err}:
+				case <-done:
+					return
+				}
+			}
+		}()
+	}
+
+	go func() {
+		defer close(indexes)
+		for i := range types {
+			select {
+			case indexes <- i:
+			case <-failed:
+				return
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	defer func() {
+		close(done)
+		wg.Wait()
+	}()
+
+	// Types generated out of order wait for the types before them
+	pending := map[int]result{}
+	for next := 0; next < len(types); {
+		r := <-results
+		if r.err != nil {
+			failOnce.Do(func() { close(failed) })
+		}
+		pending[r.i] = r
+
+		for r, ok := pending[next]; ok; r, ok = pending[next] {
+			delete(pending, next)
+			if r.err != nil {
+				return r.err
+			}
+			if err := emit(next, r.code); err != nil {
+				return err
+			}
+			next++
+		}
+	}
+	return nil
+}
+
+// header returns the generated file marker recognised by Go tooling, naming
+// the Version of the generator, followed by the package clause
+func (g *CodeGen) header(conf config.Config) string {
+	return fmt.Sprintf("%s\n\npackage %s\n\n", g.marker(), conf.Package)
+}
+
+// docHeader is header with the description of the schema definition as the
+// package doc comment, for the files generated once per package
+func (g *CodeGen) docHeader(conf config.Config) string {
+	description := schemaDescription(g.graphSchema)
+	if description == "" {
+		return g.header(conf)
+	}
+	return fmt.Sprintf("%s\n\n%s\npackage %s\n\n", g.marker(), g.godoc("Package "+conf.Package, description), conf.Package)
+}
+
+// marker returns the generated file marker, naming the schema source if set
+func (g *CodeGen) marker() string {
+	if g.source != "" {
+		return fmt.Sprintf("// Code generated by graphql-codegen v%s from %s. DO NOT EDIT.", Version, g.source)
+	}
+	return fmt.Sprintf("// Code generated by graphql-codegen v%s. DO NOT EDIT.", Version)
 }
 
 func (g *CodeGen) returnString(strPtr *string) string {
@@ -134,60 +734,168 @@ func (g *CodeGen) returnString(strPtr *string) string {
 	return ""
 }
 
-func (g *CodeGen) generateEntryPoint(conf config.Config) (string, error) {
+func (g *CodeGen) generateEntryPoint(types []*introspection.Type, conf config.Config) (string, error) {
 	// TODO figure out if this needs to be configurable
-	typeTemplate, err := codegenTemplate.GetTypeTemplate("default")
+	typeTemplate, err := codegenTemplate.GetTypeTemplateFromDir(conf.TemplateDir, "default")
 	if err != nil {
-		return "", err
+		return "", &GenerateError{TypeName: "Resolver", Template: "default", Err: err}
 	}
 
-	tmpl, err := template.New("default").Funcs(g.templateFuncMap()).Parse(strings.Trim(typeTemplate.TypeTemplate, " \t"))
+	tmpl, err := g.parseTemplate(path.Join(typeTemplate.Dir, "type/default"), strings.Trim(typeTemplate.TypeTemplate, " \t"))
 	if err != nil {
-		return "", err
+		return "", &GenerateError{TypeName: "Resolver", Template: "default", Err: err}
+	}
+
+	var roots, resolvers, rootInterfaces []string
+	if conf.GenerateRegistry {
+		for _, root := range []string{g.queryName, g.mutationName, g.subscriptionName} {
+			if root != "" {
+				roots = append(roots, root)
+			}
+		}
+		resolvers = g.resolverTypes(types, conf)
+	}
+	description := "Resolver is the main resolver for all queries"
+	if conf.RootInterfaces {
+		for _, root := range []string{g.queryName, g.mutationName, g.subscriptionName} {
+			if root != "" && !g.isIgnored(root, conf) {
+				rootInterfaces = append(rootInterfaces, g.resolverName(g.goName(root)))
+			}
+		}
+		description = "embeds the resolvers of the root operation types, set them to parse the schema with it"
+	}
+
+	var imports []string
+	if conf.EmbedImportPath != "" {
+		imports = append(imports, importFor(conf.EmbedStruct, conf.EmbedImportPath))
+	}
+
+	// The scalars asserted to be decodable by graphql-go, in name order
+	type scalarAssertion struct {
+		Name string
+		Type string
+	}
+	var scalarAssertions []scalarAssertion
+	scalarNames := make([]string, 0, len(conf.Scalar))
+	for name := range conf.Scalar {
+		scalarNames = append(scalarNames, name)
+	}
+	sort.Strings(scalarNames)
+	for _, name := range scalarNames {
+		if !conf.Scalar[name].Assert {
+			continue
+		}
+		scalar, _ := g.getTypeConfig(name, conf)
+		scalarAssertions = append(scalarAssertions, scalarAssertion{Name: name, Type: strings.TrimPrefix(scalar.goType, "*")})
+		if scalar.importPath != "" {
+			imports = append(imports, scalar.importPath)
+		}
 	}
 
 	buf := &bytes.Buffer{}
-	tmpl.Execute(buf, map[string]interface{}{
-		"Kind":            "RESOLVER",
-		"TypeName":        "Resolver",
-		"TypeDescription": "Resolver is the main resolver for all queries",
-		"Config":          conf,
+	err = tmpl.Execute(buf, map[string]interface{}{
+		"Kind":             "RESOLVER",
+		"TypeName":         g.rootResolver(),
+		"TypeDescription":  description,
+		"Roots":            roots,
+		"Resolvers":        resolvers,
+		"RootInterfaces":   rootInterfaces,
+		"EmbedName":        g.embedName(),
+		"ScalarAssertions": scalarAssertions,
+		"Imports":          imports,
+		"Config":           conf,
 	})
+	if err != nil {
+		return "", &GenerateError{TypeName: "Resolver", Template: "default", Err: err}
+	}
+
+	b, err := formatGenerated(g.header(conf) + string(buf.Bytes()))
+	if err != nil {
+		return "", &GenerateError{TypeName: "Resolver", Err: err}
+	}
+	return string(b), nil
+}
+
+// resolverTypes returns the names of the resolver types generated for types,
+// qualified with their package, in the order of types
+func (g *CodeGen) resolverTypes(types []*introspection.Type, conf config.Config) []string {
+	var resolvers []string
+	for _, tp := range types {
+		name := *tp.Name()
+		switch tp.Kind() {
+		case "OBJECT", "INTERFACE", "UNION", "SCALAR":
+			if !g.isEntryPoint(name) {
+				resolvers = append(resolvers, g.qualifiedName(name, g.resolverName(g.goName(name)), conf))
+			}
+		}
+	}
+	return resolvers
+}
+
+// generateSchema generates the Schema constant holding the SDL, without the
+// directives only read by the generator, for graphql.ParseSchema
+func (g *CodeGen) generateSchema(conf config.Config) (string, error) {
+	graphSchema, _, err := config.GoTypeDirectives(g.graphSchema)
+	if err != nil {
+		return "", err
+	}
+
+	name := g.schemaName()
+	code := g.docHeader(conf) + "// " + name + " is the schema the code was generated for\nconst " + name + " = " + rawString(graphSchema) + "\n"
+	b, err := FormatCode(code)
+	if err != nil {
+		return "", &GenerateError{TypeName: "Schema", Err: err}
+	}
+	return string(b), nil
+}
 
-	b, err := FormatCode(string(buf.Bytes()))
-	return string(b), err
+// rawString returns s as a raw string literal, with the backticks, which a
+// raw string cannot hold, concatenated as interpreted strings
+func rawString(s string) string {
+	return "`" + strings.Replace(s, "`", "` + \"`\" + `", -1) + "`"
 }
 
 func (g *CodeGen) generateType(tp *introspection.Type, conf config.Config) (code string, err error) {
 	name := *tp.Name()
-	typeConf := conf.Type[name]
+	typeConf, err := g.inheritInterfaceConfig(tp, conf.Type[name], conf)
+	if err != nil {
+		return "", err
+	}
+	// Type names are qualified relative to the package being generated
+	conf.Package, _ = g.typePackage(name, conf)
 
 	buf := &bytes.Buffer{}
 
-	if len(typeConf.Template) == 0 {
-		typeConf.Template = map[string]map[string]interface{}{}
-		typeConf.Template["default"] = map[string]interface{}{}
+	// Without configured templates the type is generated with the default
+	// template of its kind, with no template config
+	templateNames := []string{g.defaultTypeTemplate(tp.Kind())}
+	if len(typeConf.Template) > 0 {
+		templateNames = g.templateNames(typeConf.Template)
 	}
 
-	for templateName, templateConfig := range typeConf.Template {
-		typeTemplate, err := codegenTemplate.GetTypeTemplate(templateName)
+	for _, templateName := range templateNames {
+		templateConfig := typeConf.Template[templateName]
+		typeTemplate, err := codegenTemplate.GetTypeTemplateFromDir(conf.TemplateDir, templateName)
 		if err != nil {
-			return "", err
+			return "", &GenerateError{TypeName: name, Template: templateName, Err: err}
 		}
 
-		tmpl, err := template.New(templateName).Funcs(g.templateFuncMap()).Parse(strings.Trim(typeTemplate.TypeTemplate, " \t"))
+		tmpl, err := g.parseTemplate(path.Join(typeTemplate.Dir, "type", templateName), strings.Trim(typeTemplate.TypeTemplate, " \t"))
 		if err != nil {
-			return "", err
+			return "", &GenerateError{TypeName: name, Template: templateName, Err: err}
 		}
 
 		// Move this to a util func (g *CodeGen)
+		// Fields, like input fields and enum values, keep the order of the SDL,
+		// the parser lists them in declaration order
 		var ifields []*introspection.Field
-		if tp.Fields(&struct{ IncludeDeprecated bool }{true}) != nil {
-			ifields = *tp.Fields(&struct{ IncludeDeprecated bool }{true})
+		if tp.Fields(&struct{ IncludeDeprecated bool }{!typeConf.SkipDeprecated}) != nil {
+			ifields = *tp.Fields(&struct{ IncludeDeprecated bool }{!typeConf.SkipDeprecated})
 		}
 
 		fields := make([]string, len(ifields))
 		methods := make([]string, len(ifields))
+		arguments := []argumentsType{}
 		imports := []string{}
 		for i, fp := range ifields {
 			fieldCode, methodCode, fieldImports, err := g.generateField(fp, tp, typeConf, conf)
@@ -197,202 +905,1099 @@ func (g *CodeGen) generateType(tp *introspection.Type, conf config.Config) (code
 			fields[i] = fieldCode
 			methods[i] = methodCode
 
-			imports = append(imports, fieldImports...)
+			_, unwrapped := g.unwrappedInput(fp, tp, typeConf, conf)
+			if g.seedsResolvers(name, conf) {
+				// The methods are seeded into their own file, only the arguments stay
+				methods[i] = ""
+				for _, arg := range fp.Args() {
+					if !unwrapped {
+						imports = append(imports, g.getImports(arg.Type(), conf)...)
+					}
+				}
+			} else if templateName != "gqlgen" || tp.Kind() != "INTERFACE" {
+				// gqlgen interfaces only have a marker method, the fields are
+				// not used
+				imports = append(imports, fieldImports...)
+			}
+
+			if unwrapped {
+				continue
+			}
+			// Arguments shared with an interface are declared next to the interface
+			if argsName, owner := g.getArgumentsTypeName(fp, tp, conf); len(fp.Args()) > 0 && owner == nil {
+				arguments = append(arguments, argumentsType{
+					Name:      argsName,
+					FieldName: fp.Name(),
+					Fields:    g.getArguments(fp, conf),
+					Defaults:  g.argumentDefaults(argsName, fp, conf),
+				})
+			}
+		}
+
+		var inputFields []string
+		validations := []string{}
+		if tp.InputFields() != nil {
+			for _, ip := range *tp.InputFields() {
+				inputField, inputFieldImports, err := g.generateInputValue(ip, tp, typeConf, conf)
+				if err != nil {
+					return "", err
+				}
+				imports = append(imports, inputFieldImports...)
+				inputFields = append(inputFields, inputField)
+
+				// A go_type is not checked against the values of the enum
+				if typeConf.Validate && typeConf.Field[ip.Name()].GoType == "" {
+					goName := g.fieldGoName(ip.Name(), typeConf.Field[ip.Name()])
+					if validation := g.enumValidation("i."+goName, ip.Name(), ip.Type(), conf, 0); validation != "" {
+						validations = append(validations, validation)
+					}
+				}
+			}
+		}
+		if len(validations) > 0 {
+			imports = append(imports, "\"fmt\"")
+		}
+
+		// The interfaces the resolver is asserted to implement
+		interfaces := []string{}
+		if conf.AssertInterfaces && tp.Kind() == "OBJECT" && !g.generatesStructs() && tp.Interfaces() != nil {
+			for _, intf := range *tp.Interfaces() {
+				if g.isIgnored(*intf.Name(), conf) {
+					continue
+				}
+				interfaces = append(interfaces, g.qualifiedName(*intf.Name(), g.goName(*intf.Name()), conf))
+				imports = append(imports, g.getImports(intf, conf)...)
+			}
+		}
+
+		stringField, err := g.stringField(ifields, tp, typeConf, conf)
+		if err != nil {
+			return "", err
+		}
+		if stringField != nil {
+			imports = append(imports, "\"fmt\"")
+		}
+
+		memos, err := g.memoizedFields(ifields, tp, typeConf, conf)
+		if err != nil {
+			return "", err
+		}
+		if len(memos) > 0 {
+			imports = append(imports, "\"sync\"")
+		}
+
+		loaders, err := g.dataloaderFields(ifields, tp, typeConf, conf)
+		if err != nil {
+			return "", err
+		}
+		if len(loaders) > 0 {
+			imports = append(imports, "\"context\"", "\"fmt\"", dataloaderImport)
+		}
+
+		// The embedded struct shares its field name with the methods of the resolver
+		if conf.EmbedStruct != "" && tp.Kind() == "OBJECT" && !g.isEntryPoint(name) && !g.generatesStructs() {
+			for _, fp := range ifields {
+				if g.fieldGoName(fp.Name(), typeConf.Field[fp.Name()]) == g.embedName() {
+					return "", &GenerateError{TypeName: name, FieldName: fp.Name(), Err: fmt.Errorf("the resolver method %s is named like the embedded %s, rename the embedded struct", g.embedName(), conf.EmbedStruct)}
+				}
+			}
+			if conf.EmbedImportPath != "" {
+				imports = append(imports, importFor(conf.EmbedStruct, conf.EmbedImportPath))
+			}
+		}
+
+		// The interfaces and unions a gqlgen model is a member of, each needing
+		// a marker method
+		implements := []string{}
+		if templateName == "gqlgen" && tp.Kind() == "OBJECT" {
+			if tp.Interfaces() != nil {
+				for _, intf := range *tp.Interfaces() {
+					if !g.isIgnored(*intf.Name(), conf) {
+						implements = append(implements, g.goName(*intf.Name()))
+					}
+				}
+			}
+			for _, union := range g.unions[name] {
+				if !g.isIgnored(union, conf) {
+					implements = append(implements, g.goName(union))
+				}
+			}
+		}
+
+		// Possible types are listed by their Go name, with the schema name for type checks
+		possibleTypes := []string{}
+		possibleTypeResolvers := map[string]string{}
+		possibleTypeNames := map[string]string{}
+
+		if tp.PossibleTypes() != nil {
+			for _, tp := range *tp.PossibleTypes() {
+				goName := g.goName(*tp.Name())
+				possibleTypes = append(possibleTypes, goName)
+				possibleTypeResolvers[goName] = g.qualifiedName(*tp.Name(), g.resolverName(goName), conf)
+				possibleTypeNames[goName] = *tp.Name()
+				imports = append(imports, g.getImports(tp, conf)...)
+			}
+		}
+
+		type enumValue struct {
+			Name        string
+			Description string
+			Label       string
+		}
+		enumValues := []enumValue{}
+		if tp.EnumValues(&struct{ IncludeDeprecated bool }{typeConf.IncludeDeprecated}) != nil {
+			for _, value := range *tp.EnumValues(&struct{ IncludeDeprecated bool }{typeConf.IncludeDeprecated}) {
+				label, ok := typeConf.Labels[value.Name()]
+				if !ok {
+					label = value.Name()
+				}
+				enumValues = append(enumValues, enumValue{
+					Name:        value.Name(),
+					Description: strings.TrimSpace(g.returnString(value.Description())),
+					Label:       label,
+				})
+			}
+		}
+		// Deprecated values left out of the constants are still valid in the
+		// schema, so they are matched by their schema name
+		validValues := []string{}
+		if tp.Kind() == "ENUM" {
+			included := map[string]bool{}
+			for _, value := range enumValues {
+				included[value.Name] = true
+			}
+			for _, value := range *tp.EnumValues(&struct{ IncludeDeprecated bool }{true}) {
+				if included[value.Name()] {
+					validValues = append(validValues, g.goName(name)+g.capitalise(value.Name()))
+				} else {
+					validValues = append(validValues, strconv.Quote(value.Name()))
+				}
+			}
+		}
+		if err := g.checkLabels(tp, typeConf); err != nil {
+			return "", &GenerateError{TypeName: name, Err: err}
+		}
+		if typeConf.SQL && tp.Kind() != "ENUM" {
+			return "", &GenerateError{TypeName: name, Err: fmt.Errorf("sql is only supported on enum types")}
+		}
+
+		imports = append(imports, typeTemplate.Config.Imports...)
+		if val, ok := templateConfig["imports"]; ok {
+			if arr, ok := val.([]string); ok {
+				imports = append(imports, arr...)
+			}
+		}
+
+		imports = append(imports, typeConf.Imports...)
+
+		err = tmpl.Execute(buf, map[string]interface{}{
+			"Kind":                  tp.Kind(),
+			"PossibleTypes":         possibleTypes,
+			"Interfaces":            interfaces,
+			"Implements":            implements,
+			"StringField":           stringField,
+			"Memos":                 memos,
+			"Loaders":               loaders,
+			"PossibleTypeResolvers": possibleTypeResolvers,
+			"PossibleTypeNames":     possibleTypeNames,
+			"EnumValues":            enumValues,
+			"ValidValues":           validValues,
+			"Labels":                len(typeConf.Labels) > 0,
+			"SQL":                   typeConf.SQL,
+			"TypeName":              g.goName(name),
+			"TypeDescription":       strings.TrimSpace(g.returnString(tp.Description())),
+			"Source":                name,
+			"Config":                conf,
+			"Fields":                fields,
+			"InputFields":           inputFields,
+			"Validate":              typeConf.Validate,
+			"Validations":           validations,
+			"Methods":               methods,
+			"Arguments":             arguments,
+			"Imports":               g.sortImports(g.removeDuplicates(imports)),
+			"TemplateConfig":        templateConfig,
+		})
+		if err != nil {
+			return "", &GenerateError{TypeName: name, Template: templateName, Err: err}
+		}
+	}
+	//println(string(buf.Bytes()))
+	b, err := formatGenerated(g.header(conf) + string(buf.Bytes()))
+	if err != nil {
+		return "", &GenerateError{TypeName: name, Err: err}
+	}
+	return string(b), nil
+}
+
+// generateMock generates a mock implementation of an interface type with a
+// function field for every method of the interface
+func (g *CodeGen) generateMock(tp *introspection.Type, conf config.Config) (string, error) {
+	name := *tp.Name()
+	conf.Package, _ = g.typePackage(name, conf)
+
+	typeTemplate, err := codegenTemplate.GetTypeTemplateFromDir(conf.TemplateDir, "mock")
+	if err != nil {
+		return "", &GenerateError{TypeName: name, Template: "mock", Err: err}
+	}
+
+	tmpl, err := g.parseTemplate(path.Join(typeTemplate.Dir, "type", "mock"), strings.Trim(typeTemplate.TypeTemplate, " \t"))
+	if err != nil {
+		return "", &GenerateError{TypeName: name, Template: "mock", Err: err}
+	}
+
+	// Every field uses the mock template, keeping the configured signature
+	typeConf := conf.Type[name]
+	fieldConfs := map[string]config.FieldConfig{}
+	var ifields []*introspection.Field
+	if tp.Fields(&struct{ IncludeDeprecated bool }{!typeConf.SkipDeprecated}) != nil {
+		ifields = *tp.Fields(&struct{ IncludeDeprecated bool }{!typeConf.SkipDeprecated})
+	}
+	for _, fp := range ifields {
+		fieldConf := typeConf.Field[fp.Name()]
+		fieldConf.Template = map[string]map[string]interface{}{"mock": {}}
+		fieldConfs[fp.Name()] = fieldConf
+	}
+	typeConf.Field = fieldConfs
+
+	fields := make([]string, len(ifields))
+	methods := make([]string, len(ifields))
+	imports := append([]string{}, typeTemplate.Config.Imports...)
+	for i, fp := range ifields {
+		fieldCode, methodCode, fieldImports, err := g.generateField(fp, tp, typeConf, conf)
+		if err != nil {
+			return "", err
+		}
+		fields[i] = fieldCode
+		methods[i] = methodCode
+		imports = append(imports, fieldImports...)
+	}
+
+	buf := &bytes.Buffer{}
+	err = tmpl.Execute(buf, map[string]interface{}{
+		"Kind":     tp.Kind(),
+		"TypeName": g.goName(name),
+		"Config":   conf,
+		"Fields":   fields,
+		"Methods":  methods,
+		"Imports":  g.sortImports(g.removeDuplicates(imports)),
+	})
+	if err != nil {
+		return "", &GenerateError{TypeName: name, Template: "mock", Err: err}
+	}
+
+	b, err := formatGenerated(g.header(conf) + string(buf.Bytes()))
+	if err != nil {
+		return "", &GenerateError{TypeName: name, Err: err}
+	}
+	return string(b), nil
+}
+
+// generateSeed generates the file seeded with the resolver methods of an
+// entry point. Fields without a template get a stub from the body template.
+// The file is edited by hand, so it has no generated code marker.
+func (g *CodeGen) generateSeed(tp *introspection.Type, conf config.Config) (string, error) {
+	name := *tp.Name()
+
+	typeTemplate, err := codegenTemplate.GetTypeTemplateFromDir(conf.TemplateDir, "seed")
+	if err != nil {
+		return "", &GenerateError{TypeName: name, Template: "seed", Err: err}
+	}
+
+	tmpl, err := g.parseTemplate(path.Join(typeTemplate.Dir, "type", "seed"), strings.Trim(typeTemplate.TypeTemplate, " \t"))
+	if err != nil {
+		return "", &GenerateError{TypeName: name, Template: "seed", Err: err}
+	}
+
+	typeConf := conf.Type[name]
+	fieldConfs := map[string]config.FieldConfig{}
+	var ifields []*introspection.Field
+	if tp.Fields(&struct{ IncludeDeprecated bool }{!typeConf.SkipDeprecated}) != nil {
+		ifields = *tp.Fields(&struct{ IncludeDeprecated bool }{!typeConf.SkipDeprecated})
+	}
+	for _, fp := range ifields {
+		fieldConf := typeConf.Field[fp.Name()]
+		if len(fieldConf.Template) == 0 {
+			fieldConf.Template = map[string]map[string]interface{}{"body": {}}
+		}
+		fieldConfs[fp.Name()] = fieldConf
+	}
+	typeConf.Field = fieldConfs
+
+	methods := make([]string, len(ifields))
+	imports := append([]string{}, typeConf.Imports...)
+	for i, fp := range ifields {
+		_, methodCode, fieldImports, err := g.generateField(fp, tp, typeConf, conf)
+		if err != nil {
+			return "", err
+		}
+		methods[i] = methodCode
+		imports = append(imports, fieldImports...)
+	}
+
+	buf := &bytes.Buffer{}
+	err = tmpl.Execute(buf, map[string]interface{}{
+		"Kind":     tp.Kind(),
+		"TypeName": g.goName(name),
+		"Methods":  methods,
+		"Imports":  g.sortImports(g.removeDuplicates(imports)),
+		"Config":   conf,
+	})
+	if err != nil {
+		return "", &GenerateError{TypeName: name, Template: "seed", Err: err}
+	}
+
+	header := fmt.Sprintf("// Resolvers of %s, created by graphql-codegen and not overwritten afterwards\n\npackage %s\n\n", name, conf.Package)
+	b, err := formatGenerated(header + string(buf.Bytes()))
+	if err != nil {
+		return "", &GenerateError{TypeName: name, Err: err}
+	}
+	return string(b), nil
+}
+
+func (g *CodeGen) defaultTypeTemplate(kind string) string {
+	switch kind {
+	case "ENUM":
+		return "enum"
+	case "INPUT_OBJECT":
+		return "input_object"
+	}
+	if g.conf.Target == "gqlgen" && kind != "SCALAR" {
+		return "gqlgen"
+	}
+	if g.generatesStructs() {
+		return "client"
+	}
+	switch kind {
+	case "UNION":
+		return "union"
+	case "INTERFACE":
+		return "interface"
+	}
+	return "default"
+}
+
+// defaultPropertyTemplate returns the template used for the fields of the
+// named type when none is configured
+func (g *CodeGen) defaultPropertyTemplate(typeName string) string {
+	if g.generatesStructs() {
+		return "client"
+	}
+	if typeName == g.subscriptionName {
+		return "subscription"
+	}
+	return "default"
+}
+
+func (g *CodeGen) generateInputValue(ip *introspection.InputValue, tp *introspection.Type, typeConf config.TypeConfig, conf config.Config) (string, []string, error) {
+	name := ip.Name()
+	propConf := typeConf.Field[name]
+	fieldCode := &bytes.Buffer{}
+	imports := []string{}
+
+	if err := g.checkFieldGoName(name, propConf); err != nil {
+		return "", nil, &GenerateError{TypeName: *tp.Name(), FieldName: name, Err: err}
+	}
+	if propConf.GoType == "" && propConf.ImportPath != "" {
+		return "", nil, &GenerateError{TypeName: *tp.Name(), FieldName: name, Err: fmt.Errorf("import_path %q needs a go_type", propConf.ImportPath)}
+	}
+
+	templateNames := []string{"default"}
+	if len(propConf.Template) > 0 {
+		templateNames = g.templateNames(propConf.Template)
+	}
+
+	for _, templateName := range templateNames {
+		templateConfig := propConf.Template[templateName]
+		propTemplate, err := codegenTemplate.GetPropertyTemplateFromDir(conf.TemplateDir, templateName)
+		if err != nil {
+			return "", nil, &GenerateError{TypeName: *tp.Name(), FieldName: name, Template: templateName, Err: err}
+		}
+
+		tmpl, err := g.parseTemplate(path.Join(propTemplate.Dir, "field", templateName), strings.Trim(propTemplate.FieldTemplate, " \t"))
+		if err != nil {
+			return "", nil, &GenerateError{TypeName: *tp.Name(), FieldName: name, Template: templateName, Err: err}
+		}
+
+		// A configured go_type replaces the type computed from the schema type
+		fieldTypeName := g.getTypeName(ip.Type(), conf, false)
+		if propConf.GoType != "" {
+			fieldTypeName = propConf.GoType
+		}
+
+		err = tmpl.Execute(fieldCode, map[string]interface{}{
+			"TypeKind":         tp.Kind(),
+			"FieldName":        name,
+			"FieldGoName":      g.fieldGoName(name, propConf),
+			"FieldDescription": strings.TrimSpace(g.returnString(ip.Description())),
+			"FieldType":        fieldTypeName,
+			"FieldTag":         g.jsonTag(name, ip.Type()),
+			"Config":           conf,
+			"TemplateConfig":   templateConfig,
+		})
+		if err != nil {
+			return "", nil, &GenerateError{TypeName: *tp.Name(), FieldName: name, Template: templateName, Err: err}
+		}
+
+		if propConf.GoType == "" {
+			imports = append(imports, g.getImports(ip.Type(), conf)...)
+		} else if propConf.ImportPath != "" {
+			imports = append(imports, importFor(propConf.GoType, propConf.ImportPath))
+		}
+		imports = append(imports, propConf.Imports...)
+		imports = append(imports, propTemplate.Config.Imports...)
+		if val, ok := templateConfig["imports"]; ok {
+			if arr, ok := val.([]string); ok {
+				imports = append(imports, arr...)
+			}
+		}
+	}
+
+	return string(fieldCode.Bytes()), imports, nil
+}
+
+func (g *CodeGen) generateField(fp *introspection.Field, tp *introspection.Type, typeConf config.TypeConfig, conf config.Config) (string, string, []string, error) {
+	name := fp.Name()
+	typeName := *tp.Name()
+	propConf := typeConf.Field[name]
+	fieldCode := &bytes.Buffer{}
+	methodCode := &bytes.Buffer{}
+	imports := []string{}
+
+	if err := g.checkFieldGoName(name, propConf); err != nil {
+		return "", "", nil, &GenerateError{TypeName: typeName, FieldName: name, Err: err}
+	}
+	if propConf.GoType == "" && propConf.ImportPath != "" {
+		return "", "", nil, &GenerateError{TypeName: typeName, FieldName: name, Err: fmt.Errorf("import_path %q needs a go_type", propConf.ImportPath)}
+	}
+	if propConf.GoType != "" && propConf.NullStrategy != "" {
+		return "", "", nil, &GenerateError{TypeName: typeName, FieldName: name, Err: fmt.Errorf("null_strategy cannot be combined with go_type, the go_type is used as is")}
+	}
+
+	templateNames := []string{g.defaultPropertyTemplate(typeName)}
+	if len(propConf.Template) > 0 {
+		templateNames = g.templateNames(propConf.Template)
+	}
+
+	for _, templateName := range templateNames {
+		templateConfig := propConf.Template[templateName]
+		propTemplate, err := codegenTemplate.GetPropertyTemplateFromDir(conf.TemplateDir, templateName)
+		if err != nil {
+			return "", "", nil, &GenerateError{TypeName: typeName, FieldName: name, Template: templateName, Err: err}
+		}
+
+		tmpl, err := g.parseTemplate(path.Join(propTemplate.Dir, "field", templateName), strings.Trim(propTemplate.FieldTemplate, " \t"))
+		if err != nil {
+			return "", "", nil, &GenerateError{TypeName: typeName, FieldName: name, Template: templateName, Err: err}
+		}
+
+		// A configured go_type replaces the type computed from the schema type
+		fieldTypeName := g.getTypeName(fp.Type(), conf, false)
+		if propConf.GoType != "" {
+			fieldTypeName = propConf.GoType
+		}
+
+		// The struct field of a nullable scalar can use another type than the pointer returned by the resolver
+		structTypeName, nullStrategy, nullValueField := fieldTypeName, "", ""
+		if tp.Kind() == "OBJECT" && !g.isEntryPoint(typeName) && propConf.GoType == "" {
+			structTypeName, nullStrategy, nullValueField, err = g.nullStrategyType(fp.Type(), propConf.NullStrategy, fieldTypeName)
+			if err != nil {
+				return "", "", nil, &GenerateError{TypeName: typeName, FieldName: name, Template: templateName, Err: err}
+			}
+			if nullStrategy == "sqlNull" {
+				imports = append(imports, "\"database/sql\"")
+			}
+		}
+
+		fieldArguments := g.getArguments(fp, conf)
+		argumentsTypeName, owner := g.getArgumentsTypeName(fp, tp, conf)
+		argumentsParam := "*" + argumentsTypeName
+		unwrappedType, unwrapped := g.unwrappedInput(fp, tp, typeConf, conf)
+		if unwrapped {
+			argumentsTypeName, argumentsParam = unwrappedType, unwrappedType
+		}
+		// Clients only send the arguments of the entry point fields, mocks and
+		// seeded resolvers only name the arguments struct
+		namesArgs := templateName == "mock" || (g.seedsResolvers(typeName, conf) && !unwrapped)
+		if (!g.generatesStructs() || g.isEntryPoint(typeName)) && !namesArgs {
+			if owner != nil && len(fp.Args()) > 0 {
+				imports = append(imports, g.getImports(owner, conf)...)
+			}
+			for _, field := range fp.Args() {
+				imports = append(imports, g.getImports(field.Type(), conf)...)
+			}
+		}
+
+		// The fields of the roots are the methods of their interfaces
+		typeKind := tp.Kind()
+		if conf.RootInterfaces && g.isEntryPoint(typeName) {
+			if templateName != "default" && templateName != "subscription" {
+				return "", "", nil, &GenerateError{TypeName: typeName, FieldName: name, Template: templateName, Err: fmt.Errorf("root_interfaces only generates the method signatures of the default templates")}
+			}
+			typeKind = "INTERFACE"
+		}
+
+		withContext := typeConf.WithContext || propConf.WithContext
+		if withContext {
+			imports = append(imports, "\"context\"")
+		}
+		withError := typeConf.WithError || propConf.WithError
+
+		// Memoized methods cache their results keyed by the arguments as JSON
+		memo := ""
+		if propConf.Memoize && templateName == "body" {
+			memo = g.memoName(name, propConf)
+		}
+
+		err = tmpl.Execute(fieldCode, map[string]interface{}{
+			"TypeKind":         typeKind,
+			"FieldName":        name,
+			"FieldGoName":      g.fieldGoName(name, propConf),
+			"FieldDescription": strings.TrimSpace(g.returnString(fp.Description())),
+			"FieldType":        structTypeName,
+			"FieldTag":         g.jsonTag(name, fp.Type()),
+			// The resolver method signature, for fields holding a function
+			"MethodArguments":   fieldArguments,
+			"MethodArgsType":    argumentsTypeName,
+			"MethodArgs":        argumentsParam,
+			"MethodWithContext": withContext,
+			"MethodWithError":   withError,
+			"MethodReturnType":  fieldTypeName,
+			"Config":            conf,
+			"TemplateConfig":    templateConfig,
+		})
+		if err != nil {
+			return "", "", nil, &GenerateError{TypeName: typeName, FieldName: name, Template: templateName, Err: err}
+		}
+
+		tmpl, err = g.parseTemplate(path.Join(propTemplate.Dir, "method", templateName), propTemplate.MethodTemplate)
+		if err != nil {
+			return "", "", nil, &GenerateError{TypeName: typeName, FieldName: name, Template: templateName, Err: err}
+		}
+
+		err = tmpl.Execute(methodCode, map[string]interface{}{
+			"TypeKind":             typeKind,
+			"TypeName":             g.goName(typeName),
+			"MethodArguments":      fieldArguments,
+			"MethodArgsType":       argumentsTypeName,
+			"MethodArgs":           argumentsParam,
+			"MethodWithContext":    withContext,
+			"MethodWithError":      withError,
+			"MethodDescription":    strings.TrimSpace(g.returnString(fp.Description())),
+			"MethodDeprecated":     g.deprecationReason(fp),
+			"Source":               typeName + "." + name,
+			"MethodName":           name,
+			"MethodGoName":         g.fieldGoName(name, propConf),
+			"MethodReturnType":     fieldTypeName,
+			"MethodReturn":         name,
+			"MethodNullStrategy":   nullStrategy,
+			"MethodNullValueField": nullValueField,
+			"MethodValueType":      strings.TrimPrefix(fieldTypeName, "*"),
+			"MethodMemo":           memo,
+			"Config":               conf,
+			"TemplateConfig":       templateConfig,
+		})
+		if err != nil {
+			return "", "", nil, &GenerateError{TypeName: typeName, FieldName: name, Template: templateName, Err: err}
+		}
+
+		if propConf.GoType == "" {
+			imports = append(imports, g.getImports(fp.Type(), conf)...)
+		} else if propConf.ImportPath != "" {
+			imports = append(imports, importFor(propConf.GoType, propConf.ImportPath))
+		}
+		imports = append(imports, propTemplate.Config.Imports...)
+		imports = append(imports, propConf.Imports...)
+		if val, ok := templateConfig["imports"]; ok {
+			if arr, ok := val.([]string); ok {
+				imports = append(imports, arr...)
+			}
+		}
+	}
+
+	return string(fieldCode.Bytes()), string(methodCode.Bytes()), imports, nil
+}
+
+// enumValidation returns the statements checking that the enum values held by
+// expr, of the Go type getTypeName returns for tp, are known values
+func (g *CodeGen) enumValidation(expr string, fieldName string, tp *introspection.Type, conf config.Config, depth int) string {
+	if tp.Kind() == "NON_NULL" {
+		return g.enumValueValidation(expr, fieldName, tp.OfType(), conf, depth)
+	}
+	// Nullable values are pointers
+	validation := g.enumValueValidation("(*"+expr+")", fieldName, tp, conf, depth)
+	if validation == "" {
+		return ""
+	}
+	return fmt.Sprintf("if %s != nil {\n%s}\n", expr, validation)
+}
+
+func (g *CodeGen) enumValueValidation(expr string, fieldName string, tp *introspection.Type, conf config.Config, depth int) string {
+	switch tp.Kind() {
+	case "LIST":
+		value := fmt.Sprintf("v%d", depth)
+		validation := g.enumValidation(value, fieldName, tp.OfType(), conf, depth+1)
+		if validation == "" {
+			return ""
+		}
+		return fmt.Sprintf("for _, %s := range %s {\n%s}\n", value, expr, validation)
+	case "ENUM":
+		return fmt.Sprintf("if !%s.IsValid() {\nreturn fmt.Errorf(\"invalid %s value %%q for %s\", %s.String())\n}\n", expr, g.goName(*tp.Name()), fieldName, expr)
+	}
+	return ""
+}
+
+// sqlNullTypes maps the built-in scalars to their database/sql null type and
+// the field of the null type holding the value
+var sqlNullTypes = map[string][2]string{
+	"Int":     {"sql.NullInt64", "Int64"},
+	"Float":   {"sql.NullFloat64", "Float64"},
+	"String":  {"sql.NullString", "String"},
+	"Boolean": {"sql.NullBool", "Bool"},
+	"ID":      {"sql.NullString", "String"},
+}
+
+// nullStrategyType returns the struct field type of a field for the
+// configured null strategy. The strategy is only returned when it applies,
+// that is for nullable scalar fields using the zero or sqlNull strategy.
+func (g *CodeGen) nullStrategyType(tp *introspection.Type, strategy string, typeName string) (fieldType string, applied string, valueField string, err error) {
+	switch strategy {
+	case "", "pointer", "zero", "sqlNull":
+	default:
+		return "", "", "", fmt.Errorf("unknown null strategy %q, expected pointer, zero or sqlNull", strategy)
+	}
+
+	if tp.Kind() != "SCALAR" || strategy == "" || strategy == "pointer" {
+		return typeName, "", "", nil
+	}
+
+	if strategy == "zero" {
+		return strings.TrimPrefix(typeName, "*"), strategy, "", nil
+	}
+
+	sqlNull, ok := sqlNullTypes[*tp.Name()]
+	if !ok {
+		return "", "", "", fmt.Errorf("null strategy sqlNull is not supported for scalar %s", *tp.Name())
+	}
+	return sqlNull[0], strategy, sqlNull[1], nil
+}
+
+// inheritInterfaceConfig returns typeConf of the object type tp with the
+// resolver signatures of the fields declared by its interfaces configured like
+// in the interfaces, so the resolver implements the Go interfaces. A context
+// or error configured on any interface of a field is added, and the go_name of
+// an interface field is used unless the object field has the same one.
+func (g *CodeGen) inheritInterfaceConfig(tp *introspection.Type, typeConf config.TypeConfig, conf config.Config) (config.TypeConfig, error) {
+	if tp.Kind() != "OBJECT" || tp.Interfaces() == nil || len(*tp.Interfaces()) == 0 {
+		return typeConf, nil
+	}
+
+	name := *tp.Name()
+	fields := make(map[string]config.FieldConfig, len(typeConf.Field))
+	for fieldName, fieldConf := range typeConf.Field {
+		fields[fieldName] = fieldConf
+	}
+	// goNames holds the interface each inherited go_name comes from
+	goNames := map[string]string{}
+	for _, intf := range *tp.Interfaces() {
+		intfConf := conf.Type[*intf.Name()]
+		if intf.Fields(&struct{ IncludeDeprecated bool }{true}) == nil {
+			continue
 		}
-
-		var inputFields []string
-		if tp.InputFields() != nil {
-			for _, ip := range *tp.InputFields() {
-				inputField, inputFieldImports, err := g.generateInputValue(ip, tp, typeConf, conf)
-				if err != nil {
-					return "", err
+		for _, ifp := range *intf.Fields(&struct{ IncludeDeprecated bool }{true}) {
+			fieldName := ifp.Name()
+			intfFieldConf := intfConf.Field[fieldName]
+			fieldConf := fields[fieldName]
+			fieldConf.WithContext = fieldConf.WithContext || intfConf.WithContext || intfFieldConf.WithContext
+			fieldConf.WithError = fieldConf.WithError || intfConf.WithError || intfFieldConf.WithError
+			if intfFieldConf.GoName != "" && intfFieldConf.GoName != fieldConf.GoName {
+				if fieldConf.GoName != "" {
+					other := goNames[fieldName]
+					if other == "" {
+						other = name
+					}
+					return typeConf, &GenerateError{TypeName: name, FieldName: fieldName, Err: fmt.Errorf("go_name %s of %s differs from go_name %s of interface %s", fieldConf.GoName, other, intfFieldConf.GoName, *intf.Name())}
 				}
-				imports = append(imports, inputFieldImports...)
-				inputFields = append(inputFields, inputField)
+				fieldConf.GoName = intfFieldConf.GoName
+				goNames[fieldName] = *intf.Name()
 			}
+			fields[fieldName] = fieldConf
 		}
+	}
+	typeConf.Field = fields
+	return typeConf, nil
+}
 
-		possibleTypes := []string{}
+// checkLabels makes sure the labels of an enum type name its values, deprecated
+// ones included, and that no two values have the same label
+func (g *CodeGen) checkLabels(tp *introspection.Type, typeConf config.TypeConfig) error {
+	if len(typeConf.Labels) == 0 {
+		return nil
+	}
+	if tp.Kind() != "ENUM" {
+		return fmt.Errorf("labels are only supported on enum types")
+	}
 
-		if tp.PossibleTypes() != nil {
-			for _, tp := range *tp.PossibleTypes() {
-				possibleTypes = append(possibleTypes, *tp.Name())
-			}
+	names := map[string]bool{}
+	labels := map[string]string{}
+	for _, value := range *tp.EnumValues(&struct{ IncludeDeprecated bool }{true}) {
+		label, ok := typeConf.Labels[value.Name()]
+		if !ok {
+			label = value.Name()
 		}
-
-		enumValues := []string{}
-		if tp.EnumValues(&struct{ IncludeDeprecated bool }{true}) != nil {
-			for _, value := range *tp.EnumValues(&struct{ IncludeDeprecated bool }{true}) {
-				enumValues = append(enumValues, value.Name())
-			}
+		if other, ok := labels[label]; ok {
+			return fmt.Errorf("values %s and %s both have the label %q", other, value.Name(), label)
 		}
+		names[value.Name()] = true
+		labels[label] = value.Name()
+	}
 
-		imports = append(imports, typeTemplate.Config.Imports...)
-		if val, ok := templateConfig["imports"]; ok {
-			if arr, ok := val.([]string); ok {
-				imports = append(imports, arr...)
-			}
+	values := make([]string, 0, len(typeConf.Labels))
+	for value := range typeConf.Labels {
+		values = append(values, value)
+	}
+	sort.Strings(values)
+	for _, value := range values {
+		if !names[value] {
+			return fmt.Errorf("label of unknown value %s", value)
 		}
-
-		imports = append(imports, typeConf.Imports...)
-
-		tmpl.Execute(buf, map[string]interface{}{
-			"Kind":            tp.Kind(),
-			"PossibleTypes":   possibleTypes,
-			"EnumValues":      enumValues,
-			"TypeName":        name,
-			"TypeDescription": g.removeLineBreaks(g.returnString(tp.Description())),
-			"Config":          conf,
-			"Fields":          fields,
-			"InputFields":     inputFields,
-			"Methods":         methods,
-			"Imports":         g.removeDuplicates(imports),
-			"TemplateConfig":  templateConfig,
-		})
 	}
-	//println(string(buf.Bytes()))
-	b, err := FormatCode(string(buf.Bytes()))
-	return string(b), err
+	return nil
 }
 
-func (g *CodeGen) generateInputValue(ip *introspection.InputValue, tp *introspection.Type, typeConf config.TypeConfig, conf config.Config) (string, []string, error) {
-	name := ip.Name()
-	propConf := typeConf.Field[name]
-	fieldCode := &bytes.Buffer{}
-	imports := []string{}
+// stringFieldData is the field of an object type its String method prints
+type stringFieldData struct {
+	Name string
+	// Null is the condition of the field being null, empty for non-null fields
+	Null string
+	// Value is the printed value of the field
+	Value string
+}
 
-	if len(propConf.Template) == 0 {
-		propConf.Template = map[string]map[string]interface{}{}
-		propConf.Template["default"] = map[string]interface{}{}
+// stringField returns the field configured as the string_field of an object
+// type, or nil when there is none or no resolvers are generated. The field has to be a scalar or enum held
+// in the struct of the type.
+func (g *CodeGen) stringField(fields []*introspection.Field, tp *introspection.Type, typeConf config.TypeConfig, conf config.Config) (*stringFieldData, error) {
+	name := *tp.Name()
+	if typeConf.StringField == "" || g.generatesStructs() {
+		return nil, nil
+	}
+	if tp.Kind() != "OBJECT" || g.isEntryPoint(name) {
+		return nil, &GenerateError{TypeName: name, Err: fmt.Errorf("string_field is only supported on object types")}
 	}
 
-	for templateName, templateConfig := range propConf.Template {
-		propTemplate, err := codegenTemplate.GetPropertyTemplate(templateName)
-		if err != nil {
-			return "", nil, err
+	for _, fp := range fields {
+		if fp.Name() != typeConf.StringField {
+			continue
+		}
+		propConf := typeConf.Field[fp.Name()]
+		fieldType := fp.Type()
+		if fieldType.Kind() == "NON_NULL" {
+			fieldType = fieldType.OfType()
+		}
+		if fieldType.Kind() != "SCALAR" && fieldType.Kind() != "ENUM" {
+			return nil, &GenerateError{TypeName: name, FieldName: fp.Name(), Err: fmt.Errorf("string_field has to be a scalar or enum, not %s", fieldType.Kind())}
+		}
+		for templateName := range propConf.Template {
+			if templateName != "default" {
+				return nil, &GenerateError{TypeName: name, FieldName: fp.Name(), Template: templateName, Err: fmt.Errorf("string_field has to be a struct field of the default template")}
+			}
+		}
+		if propConf.GoType != "" {
+			return nil, &GenerateError{TypeName: name, FieldName: fp.Name(), Err: fmt.Errorf("string_field cannot have a go_type")}
 		}
 
-		tmpl, err := template.New(templateName).Funcs(g.templateFuncMap()).Parse(strings.Trim(propTemplate.FieldTemplate, " \t"))
+		expr := "r." + g.goName(name) + "." + g.fieldGoName(fp.Name(), propConf)
+		structType, strategy, valueField, err := g.nullStrategyType(fp.Type(), propConf.NullStrategy, g.getTypeName(fp.Type(), conf, false))
 		if err != nil {
-			return "", nil, err
+			return nil, &GenerateError{TypeName: name, FieldName: fp.Name(), Err: err}
+		}
+		switch {
+		case strategy == "sqlNull":
+			return &stringFieldData{Name: fp.Name(), Null: "!" + expr + ".Valid", Value: expr + "." + valueField}, nil
+		case strings.HasPrefix(structType, "*"):
+			return &stringFieldData{Name: fp.Name(), Null: expr + " == nil", Value: "*" + expr}, nil
 		}
+		return &stringFieldData{Name: fp.Name(), Value: expr}, nil
+	}
+	return nil, &GenerateError{TypeName: name, Err: fmt.Errorf("string_field %s is not a field of the type", typeConf.StringField)}
+}
 
-		fieldTypeName := g.getTypeName(ip.Type(), conf, false)
+// memoField is the cache of a memoized resolver method on the resolver struct
+type memoField struct {
+	Name string
+	Type string
+}
 
-		tmpl.Execute(fieldCode, map[string]interface{}{
-			"TypeKind":         tp.Kind(),
-			"FieldName":        name,
-			"FieldDescription": g.removeLineBreaks(g.returnString(ip.Description())),
-			"FieldType":        fieldTypeName,
-			"Config":           conf,
-			"TemplateConfig":   templateConfig,
+// memoizedFields returns the caches of the fields configured with memoize.
+// The methods are resolved with the body template, on the resolvers of object
+// types, the roots are shared by all requests and not memoized.
+func (g *CodeGen) memoizedFields(fields []*introspection.Field, tp *introspection.Type, typeConf config.TypeConfig, conf config.Config) ([]memoField, error) {
+	name := *tp.Name()
+	memos := []memoField{}
+	for _, fp := range fields {
+		propConf := typeConf.Field[fp.Name()]
+		if !propConf.Memoize {
+			continue
+		}
+		if g.isEntryPoint(name) {
+			return nil, &GenerateError{TypeName: name, FieldName: fp.Name(), Err: fmt.Errorf("memoize is not supported on the fields of %s, the resolver is shared by all requests", name)}
+		}
+		if tp.Kind() != "OBJECT" || g.generatesStructs() {
+			return nil, &GenerateError{TypeName: name, FieldName: fp.Name(), Err: fmt.Errorf("memoize is only supported on the resolvers of object types")}
+		}
+		if _, ok := propConf.Template["body"]; !ok {
+			return nil, &GenerateError{TypeName: name, FieldName: fp.Name(), Err: fmt.Errorf("memoize caches the resolver method of the body template, the field has no body template")}
+		}
+		fieldType := g.getTypeName(fp.Type(), conf, false)
+		if propConf.GoType != "" {
+			fieldType = propConf.GoType
+		}
+		memos = append(memos, memoField{Name: g.memoName(fp.Name(), propConf), Type: fieldType})
+	}
+	return memos, nil
+}
+
+// memoName is the name of the cache of a memoized resolver method
+func (g *CodeGen) memoName(fieldName string, propConf config.FieldConfig) string {
+	return "memo" + g.fieldGoName(fieldName, propConf)
+}
+
+type fieldArgument struct {
+	Name string
+	Type string
+	// Default is the default value of the argument in the schema, graphql-go
+	// passes it when the argument is not given. Empty if there is none.
+	Default string
+}
+
+type argumentsType struct {
+	Name      string
+	FieldName string
+	Fields    []fieldArgument
+	// Defaults are the constants of the argument defaults
+	Defaults []argumentDefault
+}
+
+// getArguments returns the fields of the arguments struct of fp. Like input
+// fields, nullable arguments are pointers, nil when the argument is null or
+// not given without a default.
+func (g *CodeGen) getArguments(fp *introspection.Field, conf config.Config) []fieldArgument {
+	fieldArguments := make([]fieldArgument, 0, len(fp.Args()))
+	for _, field := range fp.Args() {
+		fieldArguments = append(fieldArguments, fieldArgument{
+			Name:    field.Name(),
+			Type:    g.getTypeName(field.Type(), conf, true),
+			Default: g.removeLineBreaks(g.returnString(field.DefaultValue())),
 		})
+	}
+	return fieldArguments
+}
 
-		imports = append(imports, g.getImports(ip.Type(), conf)...)
-		imports = append(imports, propConf.Imports...)
-		imports = append(imports, propTemplate.Config.Imports...)
-		if val, ok := templateConfig["imports"]; ok {
-			if arr, ok := val.([]string); ok {
-				imports = append(imports, arr...)
+// getArgumentsTypeName returns the name of the struct holding the arguments of
+// fp. When tp implements an interface declaring the same field with the same
+// arguments the interface's struct is reused, so that the resolver keeps
+// satisfying the interface, and the interface is returned as owner.
+func (g *CodeGen) getArgumentsTypeName(fp *introspection.Field, tp *introspection.Type, conf config.Config) (name string, owner *introspection.Type) {
+	if tp.Interfaces() != nil {
+		for _, intf := range *tp.Interfaces() {
+			if intf.Fields(&struct{ IncludeDeprecated bool }{true}) == nil {
+				continue
+			}
+			for _, ifp := range *intf.Fields(&struct{ IncludeDeprecated bool }{true}) {
+				if ifp.Name() == fp.Name() && reflect.DeepEqual(g.getArguments(ifp, conf), g.getArguments(fp, conf)) {
+					return g.qualifiedName(*intf.Name(), g.goName(*intf.Name())+g.fieldName(fp.Name())+"Args", conf), intf
+				}
 			}
 		}
 	}
+	return g.goName(*tp.Name()) + g.fieldName(fp.Name()) + "Args", nil
+}
 
-	return string(fieldCode.Bytes()), imports, nil
+// unwrappedInput returns the arguments type of the resolver of an entry point
+// field taking a single non-null input object argument when the type unwraps
+// its input. The input object is then passed as a value, graphql-go never
+// resolves the field without it.
+func (g *CodeGen) unwrappedInput(fp *introspection.Field, tp *introspection.Type, typeConf config.TypeConfig, conf config.Config) (string, bool) {
+	if !typeConf.UnwrapInput || g.generatesStructs() || !g.isEntryPoint(*tp.Name()) || len(fp.Args()) != 1 {
+		return "", false
+	}
+	arg := fp.Args()[0]
+	if arg.Type().Kind() != "NON_NULL" || arg.Type().OfType().Kind() != "INPUT_OBJECT" {
+		return "", false
+	}
+	return fmt.Sprintf("struct{ %s %s }", g.fieldName(arg.Name()), strings.TrimPrefix(g.getTypeName(arg.Type(), conf, true), "*")), true
 }
 
-func (g *CodeGen) generateField(fp *introspection.Field, tp *introspection.Type, typeConf config.TypeConfig, conf config.Config) (string, string, []string, error) {
-	name := fp.Name()
-	typeName := *tp.Name()
-	propConf := typeConf.Field[name]
-	fieldCode := &bytes.Buffer{}
-	methodCode := &bytes.Buffer{}
-	imports := []string{}
+func (g *CodeGen) getPointer(typeName string, fp *introspection.Field) string {
+	if fp.Type().Kind() == "NON_NULL" {
+		return typeName
+	}
+	return "*" + typeName
+}
 
-	if len(propConf.Template) == 0 {
-		propConf.Template = map[string]map[string]interface{}{}
-		propConf.Template["default"] = map[string]interface{}{}
+// typePackage returns the package name and the output directory, relative to
+// the output directory, of the named type. Entry points stay in the config
+// package as they are methods of the shared Resolver.
+func (g *CodeGen) typePackage(name string, conf config.Config) (pkg string, dir string) {
+	typeConf := conf.Type[name]
+	if g.isEntryPoint(name) || (typeConf.Package == "" && typeConf.Dir == "") {
+		return g.rootPackage, ""
 	}
 
-	for templateName, templateConfig := range propConf.Template {
-		propTemplate, err := codegenTemplate.GetPropertyTemplate(templateName)
-		if err != nil {
-			return "", "", nil, err
-		}
+	pkg, dir = typeConf.Package, typeConf.Dir
+	if pkg == "" {
+		pkg = path.Base(dir)
+	}
+	if dir == "" && pkg != g.rootPackage {
+		dir = pkg
+	}
+	return pkg, dir
+}
 
-		tmpl, err := template.New(templateName).Funcs(g.templateFuncMap()).Parse(strings.Trim(propTemplate.FieldTemplate, " \t"))
-		if err != nil {
-			return "", "", nil, err
-		}
+// qualifiedName prefixes goName with the package of the named type when the
+// type is generated into another package than conf.Package
+func (g *CodeGen) qualifiedName(name string, goName string, conf config.Config) string {
+	if pkg, _ := g.typePackage(name, conf); pkg != conf.Package {
+		return pkg + "." + goName
+	}
+	return goName
+}
 
-		fieldTypeName := g.getTypeName(fp.Type(), conf, false)
+// goName returns the Go identifier of the named type, its configured go_name
+// or the name given by the namer
+func (g *CodeGen) goName(name string) string {
+	if goName := g.conf.Type[name].GoName; goName != "" {
+		return goName
+	}
+	return g.namer().TypeName(name)
+}
 
-		type fieldArgument struct {
-			Name string
-			Type string
-		}
-		fieldArguments := make([]fieldArgument, 0, len(fp.Args()))
+// rootResolver returns the name of the Resolver type the fields of the entry
+// points are methods of
+func (g *CodeGen) rootResolver() string {
+	if g.conf.RootInterfaces {
+		return g.namer().TypeName("RootResolver")
+	}
+	return g.namer().TypeName("Resolver")
+}
 
-		for _, field := range fp.Args() {
-			fieldArguments = append(fieldArguments, fieldArgument{
-				Name: field.Name(),
-				Type: g.getTypeName(field.Type(), conf, true),
-			})
-			imports = append(imports, g.getImports(field.Type(), conf)...)
-		}
+// schemaName returns the name of the Schema constant
+func (g *CodeGen) schemaName() string {
+	return g.namer().TypeName("Schema")
+}
 
-		tmpl.Execute(fieldCode, map[string]interface{}{
-			"TypeKind":         tp.Kind(),
-			"FieldName":        name,
-			"FieldDescription": g.removeLineBreaks(g.returnString(fp.Description())),
-			"FieldType":        fieldTypeName,
-			"Config":           conf,
-			"TemplateConfig":   templateConfig,
-		})
+// fieldGoName returns the Go identifier of the struct field and resolver
+// method of a field, its configured go_name or the fieldName of its schema name
+func (g *CodeGen) fieldGoName(name string, propConf config.FieldConfig) string {
+	if propConf.GoName != "" {
+		return propConf.GoName
+	}
+	return g.fieldName(name)
+}
 
-		tmpl, err = template.New(templateName).Funcs(g.templateFuncMap()).Parse(propTemplate.MethodTemplate)
-		if err != nil {
-			return "", "", nil, err
-		}
+// checkFieldGoName makes sure the go_name of a field is an exported identifier
+// graphql-go can bind the field to. It matches resolver methods and input
+// struct fields ignoring case and underscores, so in the resolvers mode the
+// go_name can only change those, e.g. IDStr for id_str.
+func (g *CodeGen) checkFieldGoName(name string, propConf config.FieldConfig) error {
+	if propConf.GoName == "" {
+		return nil
+	}
+	if !token.IsIdentifier(propConf.GoName) || !token.IsExported(propConf.GoName) {
+		return fmt.Errorf("invalid go_name %q, expected an exported identifier", propConf.GoName)
+	}
+	fold := func(s string) string {
+		return strings.ToLower(strings.Replace(s, "_", "", -1))
+	}
+	if !g.generatesStructs() && fold(propConf.GoName) != fold(name) {
+		return fmt.Errorf("go_name %q does not match %s ignoring case and underscores, graphql-go would not find the resolver", propConf.GoName, name)
+	}
+	return nil
+}
 
-		tmpl.Execute(methodCode, map[string]interface{}{
-			"TypeKind":          tp.Kind(),
-			"TypeName":          typeName,
-			"MethodArguments":   fieldArguments,
-			"MethodDescription": g.removeLineBreaks(g.returnString(fp.Description())),
-			"MethodName":        name,
-			"MethodReturnType":  fieldTypeName,
-			"MethodReturn":      name,
-			"Config":            conf,
-			"TemplateConfig":    templateConfig,
-		})
+// fieldName returns the Go name of a field or argument without a go_name,
+// named by the namer
+func (g *CodeGen) fieldName(name string) string {
+	return g.namer().FieldName(name)
+}
 
-		imports = append(imports, g.getImports(fp.Type(), conf)...)
-		imports = append(imports, propTemplate.Config.Imports...)
-		imports = append(imports, propConf.Imports...)
-		if val, ok := templateConfig["imports"]; ok {
-			if arr, ok := val.([]string); ok {
-				imports = append(imports, arr...)
+// checkGoNames makes sure the renamed types have valid Go names that do not
+// clash with the name of another generated type
+func (g *CodeGen) checkGoNames(types []*introspection.Type) error {
+	names := map[string]string{}
+	for _, tp := range types {
+		name := *tp.Name()
+		goName := g.goName(name)
+		if g.conf.Type[name].GoName != "" {
+			if g.isEntryPoint(name) {
+				return fmt.Errorf("entry point %s cannot be renamed, its fields are methods of %s", name, g.rootResolver())
 			}
+			if !token.IsIdentifier(goName) || token.IsKeyword(goName) {
+				return fmt.Errorf("invalid go_name %q for type %s", goName, name)
+			}
+		}
+		if g.conf.Namer != nil && (!token.IsIdentifier(goName) || token.IsKeyword(goName)) {
+			return fmt.Errorf("invalid Go name %q from the namer for type %s", goName, name)
 		}
+		if other, ok := names[goName]; ok {
+			return fmt.Errorf("types %s and %s are both named %s in Go", other, name, goName)
+		}
+		names[goName] = name
 	}
+	return nil
+}
 
-	return string(fieldCode.Bytes()), string(methodCode.Bytes()), imports, nil
+// resolverName returns the name of the resolver type of the named type.
+// Clients and models have no resolvers, their fields reference the types
+// themselves.
+func (g *CodeGen) resolverName(name string) string {
+	if g.generatesStructs() {
+		return name
+	}
+	return g.namer().ResolverName(name)
 }
 
-func (g *CodeGen) getPointer(typeName string, fp *introspection.Field) string {
-	if fp.Type().Kind() == "NON_NULL" {
-		return typeName
+// aliasedType replaces the package name qualifying goType, e.g. the types of
+// *types.Time, with alias
+func aliasedType(goType string, alias string) string {
+	name := strings.TrimLeft(goType, "*[]")
+	dot := strings.Index(name, ".")
+	if dot < 0 {
+		return goType
 	}
-	return "*" + typeName
+	return goType[:len(goType)-len(name)] + alias + name[dot:]
+}
+
+// getTypeConfig returns the Go type mapping for a built-in or configured
+// scalar. The scalar config takes precedence, so it can remap built-ins like ID.
+func (g *CodeGen) getTypeConfig(name string, conf config.Config) (typeConfig, bool) {
+	if scalar, ok := conf.Scalar[name]; ok {
+		importPath := ""
+		if scalar.ImportPath != "" {
+			importPath = importFor(scalar.GoType, scalar.ImportPath)
+		}
+		goType := scalar.GoType
+		if scalar.Alias != "" {
+			importPath = fmt.Sprintf("%s %q", scalar.Alias, scalar.ImportPath)
+			goType = aliasedType(goType, scalar.Alias)
+		}
+		return typeConfig{true, goType, importPath}, true
+	}
+	val, ok := internalTypeConfig[name]
+	return val, ok
 }
 
+// getImports returns the import needed for the named type innermost in the
+// LIST and NON_NULL wrappers of tp, at any depth
 func (g *CodeGen) getImports(tp *introspection.Type, conf config.Config) []string {
 	name := tp.Name()
 	if name != nil {
-		if val, ok := internalTypeConfig[*name]; ok {
+		if val, ok := g.getTypeConfig(*name, conf); ok {
 			return []string{val.importPath}
 		}
+		if pkg, dir := g.typePackage(*name, conf); pkg != conf.Package {
+			return []string{fmt.Sprintf("%s %q", pkg, path.Join(conf.ImportPath, dir))}
+		}
 	}
 
 	if tp.OfType() != nil {
@@ -402,6 +2007,10 @@ func (g *CodeGen) getImports(tp *introspection.Type, conf config.Config) []strin
 	return []string{}
 }
 
+// getTypeName returns the Go type for tp following the neelance/graphql-go
+// conventions: every nullable level, including nullable lists, is a pointer
+// (so [[Int!]] becomes *[][]int32) and object types are always referenced
+// through a pointer to their resolver.
 func (g *CodeGen) getTypeName(tp *introspection.Type, conf config.Config, input bool) (typ string) {
 check:
 	if tp.Kind() == "NON_NULL" {
@@ -412,17 +2021,24 @@ check:
 
 	if tp.Kind() == "LIST" {
 		tp = tp.OfType()
+		// gqlgen models hold nullable lists as nil slices
+		if conf.Target == "gqlgen" {
+			typ = strings.TrimSuffix(typ, "*")
+		}
 		typ = typ + "[]"
 		goto check
 	}
 
 	name := tp.Name()
-	if val, ok := internalTypeConfig[*name]; ok {
+	if val, ok := g.getTypeConfig(*name, conf); ok {
 		return typ + val.goType
 	}
 
 	if tp.Kind() == "ENUM" {
-		typ = typ + *name
+		typ = typ + g.qualifiedName(*name, g.goName(*name), conf)
+	} else if conf.Target == "gqlgen" && (tp.Kind() == "INTERFACE" || tp.Kind() == "UNION") {
+		// gqlgen models hold abstract types as Go interfaces
+		typ = strings.TrimSuffix(typ, "*") + g.qualifiedName(*name, g.goName(*name), conf)
 	} else if tp.Kind() != "INPUT_OBJECT" {
 		if len(typ) > 0 {
 			if typ[len(typ)-1] != '*' {
@@ -431,23 +2047,30 @@ check:
 		} else {
 			typ = "*"
 		}
-		typ = typ + *name + "Resolver"
+		typ = typ + g.qualifiedName(*name, g.resolverName(g.goName(*name)), conf)
 	} else {
-		typ = typ + *name
+		typ = typ + g.qualifiedName(*name, g.goName(*name), conf)
 	}
 
-	if typ[0] != '*' && tp.Kind() == "INPUT_OBJECT" {
+	// Non-null input object arguments are passed as pointers too, except as
+	// the elements of a list
+	if input && tp.Kind() == "INPUT_OBJECT" && !strings.HasPrefix(typ, "*") && !strings.HasPrefix(typ, "[") {
 		typ = "*" + typ
 	}
 
 	return
 }
 
+// removeDuplicates returns the trimmed values of a without duplicates. Empty
+// values, such as the import path of a built-in scalar, are left out.
 func (g *CodeGen) removeDuplicates(a []string) []string {
 	result := []string{}
 	seen := map[string]string{}
 	for _, val := range a {
 		val = strings.TrimSpace(val)
+		if val == "" {
+			continue
+		}
 		if _, ok := seen[val]; !ok {
 			result = append(result, val)
 			seen[val] = val
@@ -456,8 +2079,137 @@ func (g *CodeGen) removeDuplicates(a []string) []string {
 	return result
 }
 
+func (g *CodeGen) sortImports(imports []string) []string {
+	sort.Strings(imports)
+	return imports
+}
+
+// importBlock renders imports as an import declaration, leaving out empty
+// and duplicate entries. Standard library imports are grouped before the
+// others, each group sorted by path. No imports render as an empty string.
+func (g *CodeGen) importBlock(imports []string) string {
+	var std, thirdParty []string
+	for _, line := range g.removeDuplicates(imports) {
+		if isStdLib(strings.Trim(importPath(line), "\"")) {
+			std = append(std, line)
+		} else {
+			thirdParty = append(thirdParty, line)
+		}
+	}
+	if len(std) == 0 && len(thirdParty) == 0 {
+		return ""
+	}
+	sortByPath(std)
+	sortByPath(thirdParty)
+
+	block := &bytes.Buffer{}
+	block.WriteString("import (\n")
+	for _, line := range std {
+		fmt.Fprintf(block, "\t%s\n", line)
+	}
+	if len(std) > 0 && len(thirdParty) > 0 {
+		block.WriteString("\n")
+	}
+	for _, line := range thirdParty {
+		fmt.Fprintf(block, "\t%s\n", line)
+	}
+	block.WriteString(")\n")
+	return block.String()
+}
+
+// templateNames returns the configured template names in a stable order
+func (g *CodeGen) templateNames(templates map[string]map[string]interface{}) []string {
+	names := make([]string, 0, len(templates))
+	for name := range templates {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// isIgnored reports whether the type name matches one of the ignore patterns
+func (g *CodeGen) isIgnored(name string, conf config.Config) bool {
+	for _, pattern := range conf.Ignore {
+		if matched, _ := path.Match(pattern, name); matched {
+			return true
+		}
+	}
+	return false
+}
+
+// jsonTag returns the struct tag of the field with the schema name, or
+// nothing when the json tags are turned off. Nullable fields are omitted when
+// empty unless omit_empty says otherwise.
+func (g *CodeGen) jsonTag(name string, tp *introspection.Type) string {
+	if g.conf.JSONTags != nil && !*g.conf.JSONTags {
+		return ""
+	}
+	switch g.conf.OmitEmpty {
+	case "", "nullable":
+		if tp.Kind() != "NON_NULL" {
+			name += ",omitempty"
+		}
+	case "always":
+		name += ",omitempty"
+	}
+	return fmt.Sprintf("`json:%q`", name)
+}
+
+// logf reports progress to the configured logger
+func (g *CodeGen) logf(format string, args ...interface{}) {
+	if g.conf.Logger != nil {
+		g.conf.Logger(format, args...)
+		return
+	}
+	log.Printf(format, args...)
+}
+
+// seedsResolvers reports whether the resolvers of the named type are seeded
+// into a file of their own instead of being generated. Only the query and
+// mutation types are seeded, the other resolvers are generated from the schema.
+func (g *CodeGen) seedsResolvers(name string, conf config.Config) bool {
+	return conf.SeedResolvers && !g.generatesStructs() && (name == g.queryName || name == g.mutationName)
+}
+
+// generatesStructs reports whether plain structs are generated instead of
+// server resolvers, with the request and response structs of the entry points
+// in client mode. The gqlgen target generates models.
+func (g *CodeGen) generatesStructs() bool {
+	return g.conf.Mode == "client" || g.conf.Mode == "models" || g.conf.Target == "gqlgen"
+}
+
 func (g *CodeGen) isEntryPoint(a string) bool {
-	return a == g.mutationName || a == g.queryName
+	return a == g.mutationName || a == g.queryName || a == g.subscriptionName
+}
+
+// isEntryGoName reports whether the Go name of an entry point is a, for the
+// templates getting the Go names of the types
+func (g *CodeGen) isEntryGoName(a string) bool {
+	for _, name := range []string{g.mutationName, g.queryName, g.subscriptionName} {
+		if name != "" && a == g.goName(name) {
+			return true
+		}
+	}
+	return false
+}
+
+// deprecationReason returns the reason fp is deprecated for, or an empty
+// string when the field is not deprecated. The reason is put on a single
+// comment line, so line breaks and other control characters become spaces.
+func (g *CodeGen) deprecationReason(fp *introspection.Field) string {
+	if !fp.IsDeprecated() {
+		return ""
+	}
+	reason := strings.Map(func(r rune) rune {
+		if unicode.IsControl(r) {
+			return ' '
+		}
+		return r
+	}, g.returnString(fp.DeprecationReason()))
+	if reason = strings.Join(strings.Fields(reason), " "); reason != "" {
+		return reason
+	}
+	return "No longer supported"
 }
 
 func (g *CodeGen) removeLineBreaks(a string) string {
@@ -468,11 +2220,78 @@ func (g *CodeGen) capitalise(str string) string {
 	if strings.ToLower(str) == "id" {
 		return "ID"
 	}
-	return strings.ToUpper(string(str[0])) + str[1:]
+	// Leading underscores would keep the identifier unexported
+	if trimmed := strings.TrimLeft(str, "_"); trimmed != "" {
+		str = trimmed
+	}
+	if str == "" {
+		return str
+	}
+	// The first character is a rune, a byte would split a multi-byte one
+	first, size := utf8.DecodeRuneInString(str)
+	return string(unicode.ToUpper(first)) + str[size:]
 }
 
 func (g *CodeGen) unCapitalise(str string) string {
-	return strings.ToLower(string(str[0])) + str[1:]
+	if str == "" {
+		return str
+	}
+	first, size := utf8.DecodeRuneInString(str)
+	return g.escapeKeyword(string(unicode.ToLower(first)) + str[size:])
+}
+
+// escapeKeyword appends the configured suffix to Go reserved words so they
+// can be used as identifiers
+func (g *CodeGen) escapeKeyword(str string) string {
+	if !token.IsKeyword(str) {
+		return str
+	}
+	if g.conf.KeywordSuffix != "" {
+		return str + g.conf.KeywordSuffix
+	}
+	return str + "_"
+}
+
+// godoc joins the non-empty parts with spaces and renders the result as Go
+// line comments, one "// " line per line of text, so that no text like */
+// ends the comment
+func (g *CodeGen) godoc(parts ...string) string {
+	words := make([]string, 0, len(parts))
+	for _, part := range parts {
+		if part != "" {
+			words = append(words, part)
+		}
+	}
+	if len(words) == 0 {
+		return ""
+	}
+
+	// Line comments hold any text but control characters, like NUL, which
+	// are not allowed in Go source
+	text := strings.Map(func(r rune) rune {
+		if r == '\r' {
+			return -1
+		}
+		if unicode.IsControl(r) && r != '\n' && r != '\t' {
+			return ' '
+		}
+		return r
+	}, strings.Join(words, " "))
+	lines := strings.Split(text, "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimRight("// "+strings.TrimSpace(line), " ")
+	}
+	return strings.Join(lines, "\n")
+}
+
+// sourceComment returns the line naming the schema type or field code is
+// generated from, preceded by a line break to follow a doc comment, when
+// annotate_source is set
+func (g *CodeGen) sourceComment(source string) string {
+	if !g.conf.AnnotateSource {
+		return ""
+	}
+	return "\n// source: " + source
 }
 
 func (g *CodeGen) subTemplate(str string, val interface{}) string {
@@ -498,13 +2317,105 @@ func (g *CodeGen) includesString(strings []string, str string) bool {
 }
 
 func (g *CodeGen) templateFuncMap() template.FuncMap {
+	funcs := g.builtinFuncMap()
+	for name, fn := range g.conf.TemplateFuncs {
+		if _, ok := funcs[name]; !ok {
+			funcs[name] = fn
+		}
+	}
+	return funcs
+}
+
+// embedName returns the name of the field of the embedded struct, the type
+// name of EmbedStruct without its package
+func (g *CodeGen) embedName() string {
+	return g.conf.EmbedStruct[strings.LastIndex(g.conf.EmbedStruct, ".")+1:]
+}
+
+// checkEmbedStruct returns an error for an embed_struct that is not a type
+// name, optionally qualified with its package, or that needs its import path
+func (g *CodeGen) checkEmbedStruct(conf config.Config) error {
+	if conf.EmbedStruct == "" {
+		if conf.EmbedImportPath != "" {
+			return fmt.Errorf("embed_import_path %q needs an embed_struct", conf.EmbedImportPath)
+		}
+		return nil
+	}
+	parts := strings.Split(conf.EmbedStruct, ".")
+	for _, part := range parts {
+		if !token.IsIdentifier(part) {
+			return fmt.Errorf("invalid embed_struct %q, expected a type name like Deps or deps.Deps", conf.EmbedStruct)
+		}
+	}
+	if len(parts) > 2 || (len(parts) == 2 && !token.IsExported(parts[1])) {
+		return fmt.Errorf("invalid embed_struct %q, expected a type name like Deps or deps.Deps", conf.EmbedStruct)
+	}
+	if len(parts) == 1 && conf.EmbedImportPath != "" {
+		return fmt.Errorf("embed_struct %s is in the generated package, it has no embed_import_path", conf.EmbedStruct)
+	}
+	return nil
+}
+
+// checkEmbedPackage returns an error for an embed_struct of the generated
+// package when a resolver embedding it is generated into another package,
+// where the struct cannot be referred to without its package
+func (g *CodeGen) checkEmbedPackage(types []*introspection.Type, conf config.Config) error {
+	if conf.EmbedStruct == "" || strings.Contains(conf.EmbedStruct, ".") || g.generatesStructs() {
+		return nil
+	}
+	for _, tp := range types {
+		name := *tp.Name()
+		if tp.Kind() != "OBJECT" || g.isEntryPoint(name) {
+			continue
+		}
+		if pkg, dir := g.typePackage(name, conf); dir != "" {
+			return fmt.Errorf("embed_struct %s is in the generated package, type %s is generated into package %s and cannot embed it, move it to a package of its own with embed_import_path", conf.EmbedStruct, name, pkg)
+		}
+	}
+	return nil
+}
+
+// checkTemplateFuncs returns an error for the custom template funcs taking the
+// name of a built-in func, or which text/template does not accept as a func
+func (g *CodeGen) checkTemplateFuncs(funcs template.FuncMap) (err error) {
+	builtin := g.builtinFuncMap()
+	names := make([]string, 0, len(funcs))
+	for name := range funcs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if _, ok := builtin[name]; ok {
+			return fmt.Errorf("template func %s is a built-in func", name)
+		}
+	}
+
+	// Funcs panics on invalid names and values
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("invalid template funcs: %v", r)
+		}
+	}()
+	template.New("template_funcs").Funcs(funcs)
+	return nil
+}
+
+func (g *CodeGen) builtinFuncMap() template.FuncMap {
 	return template.FuncMap{
 		"capitalize":         g.capitalise,
+		"field_name":         g.fieldName,
 		"uncapitalize":       g.unCapitalise,
-		"is_entry":           g.isEntryPoint,
+		"pluralize":          g.pluralize,
+		"singularize":        g.singularize,
+		"is_entry":           g.isEntryGoName,
+		"root_resolver":      g.rootResolver,
 		"remove_line_breaks": g.removeLineBreaks,
+		"godoc":              g.godoc,
+		"source_comment":     g.sourceComment,
+		"resolver":           g.resolverName,
 		"sub_template":       g.subTemplate,
 		"sprintf":            fmt.Sprintf,
 		"includes_string":    g.includesString,
+		"import_block":       g.importBlock,
 	}
 }
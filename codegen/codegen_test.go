@@ -1,13 +1,22 @@
 package codegen
 
 import (
+	"errors"
+	"fmt"
+	"io"
 	"io/ioutil"
 	"os"
 	"path"
+	"path/filepath"
+	"reflect"
+	"regexp"
 	"strings"
 	"testing"
+	"text/template"
 
 	"github.com/Applifier/graphql-codegen/config"
+	graphql "github.com/neelance/graphql-go"
+	"github.com/neelance/graphql-go/introspection"
 )
 
 const fixtureDir = "fixtures"
@@ -24,15 +33,18 @@ func TestCodegen(t *testing.T) {
 
 		schemaBytes, _ := ioutil.ReadFile(path.Join(fixtureDir, testDir.Name(), "schema.graphql"))
 		confBytes, _ := ioutil.ReadFile(path.Join(fixtureDir, testDir.Name(), "config.hcl"))
-		exectedFiles, _ := ioutil.ReadDir(path.Join(fixtureDir, testDir.Name()))
 		exectedFilesMap := map[string]string{}
 
-		for _, f := range exectedFiles {
-			if strings.HasSuffix(f.Name(), ".go") {
-				expectedBytes, _ := ioutil.ReadFile(path.Join(fixtureDir, testDir.Name(), f.Name()))
-				exectedFilesMap[f.Name()] = string(expectedBytes)
+		// Types generated into their own package are recorded in subdirectories
+		testPath := path.Join(fixtureDir, testDir.Name())
+		filepath.Walk(testPath, func(filePath string, info os.FileInfo, err error) error {
+			if err == nil && strings.HasSuffix(info.Name(), ".go") {
+				expectedBytes, _ := ioutil.ReadFile(filePath)
+				relPath, _ := filepath.Rel(testPath, filePath)
+				exectedFilesMap[filepath.ToSlash(relPath)] = string(expectedBytes)
 			}
-		}
+			return err
+		})
 
 		cfg, err := config.Parse(string(confBytes))
 		if err != nil {
@@ -42,8 +54,2201 @@ func TestCodegen(t *testing.T) {
 		fileMap := RunTest(string(schemaBytes), cfg, exectedFilesMap, t)
 		if os.Getenv("RECORD_FIXTURES") == "yes" {
 			for filename, data := range fileMap {
+				os.MkdirAll(path.Dir(path.Join(fixtureDir, testDir.Name(), filename)), 0755)
 				ioutil.WriteFile(path.Join(fixtureDir, testDir.Name(), filename), []byte(data), 0644)
 			}
 		}
 	}
 }
+
+func TestGeneratedHeader(t *testing.T) {
+	generatedFile := regexp.MustCompile(`(?m)^// Code generated .* DO NOT EDIT\.$`)
+	schema := `
+		type Query {
+			hello: String!
+		}
+	`
+
+	for _, source := range []string{"", "schema.graphql"} {
+		cg := NewCodeGen(schema, config.Config{Package: "main"})
+		cg.SetSource(source)
+		fileMap, err := cg.Generate()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		for file, code := range fileMap {
+			if !generatedFile.MatchString(code) {
+				t.Errorf("Generated file %s is missing the generated code marker\n%s", file, code)
+			}
+			if source != "" && !strings.Contains(code, "from "+source) {
+				t.Errorf("Generated file %s header should mention %s\n%s", file, source, code)
+			}
+			if !strings.HasPrefix(code, "// Code generated by graphql-codegen v"+Version) {
+				t.Errorf("Generated file %s header should name version %s\n%s", file, Version, code)
+			}
+		}
+	}
+}
+
+func TestPackageName(t *testing.T) {
+	schema := `
+		type Query {
+			hello: String!
+		}
+	`
+
+	fileMap, err := NewCodeGen(schema, config.Config{}).Generate()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(fileMap["query_gen.go"], "\npackage main\n") {
+		t.Errorf("Package should default to main\n%s", fileMap["query_gen.go"])
+	}
+
+	for _, pkg := range []string{"my-package", "func", "1st", "_"} {
+		if _, err := NewCodeGen(schema, config.Config{Package: pkg}).Generate(); err == nil {
+			t.Errorf("Package name %q should be rejected", pkg)
+		}
+	}
+
+	for _, typeConf := range []config.TypeConfig{{Package: "my-package"}, {Dir: "models"}} {
+		conf := config.Config{
+			ImportPath: "example.com/generated",
+			Type:       map[string]config.TypeConfig{"Hello": typeConf},
+		}
+		if typeConf.Package == "" {
+			conf.ImportPath = ""
+		}
+		if _, err := NewCodeGen(schema, conf).Generate(); err == nil {
+			t.Errorf("Type config %+v should be rejected", typeConf)
+		}
+	}
+}
+
+func TestInterrelatedTypes(t *testing.T) {
+	schema := `
+		scalar Time
+
+		type Query {
+			user: User!
+		}
+
+		type User {
+			name: String!
+			posts: [Post!]!
+		}
+
+		type Post {
+			title: String!
+			author: User!
+			published: Time!
+		}
+	`
+
+	conf := config.Config{
+		ImportPath: "example.com/generated",
+		Scalar:     map[string]config.ScalarConfig{"Time": {GoType: "time.Time", ImportPath: "time"}},
+		Type: map[string]config.TypeConfig{
+			"User": {Dir: "models", Imports: []string{`"strings"`}},
+			"Post": {Dir: "models"},
+		},
+	}
+	fileMap, err := NewCodeGen(schema, conf).Generate()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, fileName := range []string{"models/user_gen.go", "models/post_gen.go"} {
+		if !strings.Contains(fileMap[fileName], "\npackage models\n") {
+			t.Errorf("Expected %s to be in package models\n%s", fileName, fileMap[fileName])
+		}
+	}
+	if !strings.Contains(fileMap["models/post_gen.go"], `"time"`) {
+		t.Errorf("Expected Post to import the package of Time\n%s", fileMap["models/post_gen.go"])
+	}
+	for _, importPath := range []string{`"time"`, `"strings"`} {
+		if strings.Contains(fileMap["models/user_gen.go"], importPath) {
+			t.Errorf("Expected User not to import %s it does not use\n%s", importPath, fileMap["models/user_gen.go"])
+		}
+	}
+	if !strings.Contains(fileMap["query_gen.go"], `models "example.com/generated/models"`) || strings.Contains(fileMap["query_gen.go"], `"time"`) {
+		t.Errorf("Expected Query to import only the package of User\n%s", fileMap["query_gen.go"])
+	}
+
+	conf.Type["User"] = config.TypeConfig{Dir: "models", Package: "users"}
+	if _, err := NewCodeGen(schema, conf).Generate(); err == nil || !strings.Contains(err.Error(), "as packages models and users") {
+		t.Errorf("Expected an error for two packages in one directory, got %v", err)
+	}
+}
+
+func TestMode(t *testing.T) {
+	schema := `
+		type Query {
+			hello: String!
+		}
+	`
+
+	fileMap, err := NewCodeGen(schema, config.Config{Mode: "client"}).Generate()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := fileMap["resolver_gen.go"]; ok {
+		t.Error("Clients should not get a Resolver")
+	}
+	if !strings.Contains(fileMap["query_gen.go"], "type QueryHelloResponse struct") {
+		t.Errorf("Client should get a response struct for Query.hello\n%s", fileMap["query_gen.go"])
+	}
+
+	fileMap, err = NewCodeGen(schema, config.Config{Mode: "models"}).Generate()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(fileMap) != 0 {
+		t.Errorf("Models should not get the entry points, got %v", sortedFileNames(fileMap))
+	}
+
+	if _, err := NewCodeGen(schema, config.Config{Mode: "mock"}).Generate(); err == nil {
+		t.Error("Unknown mode should be rejected")
+	}
+}
+
+func TestFieldlessTypes(t *testing.T) {
+	schema := `
+		type Query {
+			placeholder: Placeholder
+			node: Node
+			result: Result
+		}
+
+		type Placeholder implements Node {}
+
+		interface Node {}
+
+		union Result = Placeholder
+
+		input Filter {}
+	`
+
+	for _, conf := range []config.Config{
+		{},
+		{Mocks: true, AssertInterfaces: true, GenerateRegistry: true},
+		{RootInterfaces: true},
+		{Mode: "client"},
+		{Mode: "models"},
+		{Target: "gqlgen"},
+	} {
+		fileMap, err := NewCodeGen(schema, conf).Generate()
+		if err != nil {
+			t.Fatalf("Expected types without fields to be generated with %+v, got %v", conf, err)
+		}
+		for fileName, expected := range map[string]string{
+			"placeholder_gen.go": "type Placeholder struct {\n}",
+			"filter_gen.go":      "type Filter struct {\n}",
+		} {
+			if !strings.Contains(fileMap[fileName], expected) {
+				t.Errorf("Expected %s with %+v in\n%s", expected, conf, fileMap[fileName])
+			}
+		}
+	}
+}
+
+func TestIncludeMetaTypes(t *testing.T) {
+	schema := `
+		type Query {
+			hello: String
+		}
+	`
+
+	fileMap, err := NewCodeGen(schema, config.Config{}).Generate()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := fileMap["type_gen.go"]; ok {
+		t.Errorf("Expected no introspection types without include_meta_types, got %v", sortedFileNames(fileMap))
+	}
+
+	fileMap, err = NewCodeGen(schema, config.Config{IncludeMetaTypes: true}).Generate()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for fileName, expected := range map[string]string{
+		"type_gen.go":     "func (r *TypeResolver) OfType() *TypeResolver {",
+		"schema_gen.go":   "Types []*TypeResolver",
+		"typekind_gen.go": "TypeKindSCALAR TypeKind = \"SCALAR\"",
+	} {
+		if !strings.Contains(fileMap[fileName], expected) {
+			t.Errorf("Expected %s in %s\n%s", expected, fileName, fileMap[fileName])
+		}
+	}
+}
+
+func TestUnwrapInput(t *testing.T) {
+	schema := `
+		type Query {
+			user(filter: UserFilter!): User
+		}
+
+		type User {
+			name(filter: UserFilter!): String!
+		}
+
+		input UserFilter {
+			name: String
+		}
+	`
+
+	conf := config.Config{Type: map[string]config.TypeConfig{"Query": {UnwrapInput: true}}}
+	fileMap, err := NewCodeGen(schema, conf).Generate()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(fileMap["query_gen.go"], "User(args struct{ Filter UserFilter }) *UserResolver") {
+		t.Errorf("Query.user should take the unwrapped input\n%s", fileMap["query_gen.go"])
+	}
+	if strings.Contains(fileMap["query_gen.go"], "QueryUserArgs") {
+		t.Errorf("Query.user should not get an arguments struct\n%s", fileMap["query_gen.go"])
+	}
+
+	conf = config.Config{Type: map[string]config.TypeConfig{"User": {UnwrapInput: true}}}
+	if _, err := NewCodeGen(schema, conf).Generate(); err == nil {
+		t.Error("unwrap_input should be rejected on a type that is not an entry point")
+	}
+}
+
+func TestSchemaRoots(t *testing.T) {
+	schema := `
+		schema {
+			query: RootQuery
+			mutation: RootMutation
+			subscription: RootSubscription
+		}
+
+		type RootQuery {
+			user: User
+		}
+
+		type RootMutation {
+			createUser(input: UserInput!): User
+		}
+
+		type RootSubscription {
+			userCreated: User!
+		}
+
+		type Query {
+			name: String!
+		}
+
+		type User {
+			name: String!
+		}
+
+		input UserInput {
+			name: String!
+		}
+	`
+
+	conf := config.Config{Type: map[string]config.TypeConfig{"RootMutation": {UnwrapInput: true}}}
+	fileMap, err := NewCodeGen(schema, conf).Generate()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for file, expected := range map[string]string{
+		"rootquery_gen.go":        "func (r *Resolver) User() *UserResolver",
+		"rootmutation_gen.go":     "func (r *Resolver) CreateUser(args struct{ Input UserInput }) *UserResolver",
+		"rootsubscription_gen.go": "func (r *Resolver) UserCreated() <-chan *UserResolver",
+		"query_gen.go":            "type QueryResolver struct",
+	} {
+		if !strings.Contains(fileMap[file], expected) {
+			t.Errorf("Expected %s in\n%s", expected, fileMap[file])
+		}
+	}
+
+	conf = config.Config{Type: map[string]config.TypeConfig{"Query": {UnwrapInput: true}}}
+	if _, err := NewCodeGen(schema, conf).Generate(); err == nil {
+		t.Error("unwrap_input should be rejected on Query when it is not a root")
+	}
+}
+
+func TestArgumentDefaults(t *testing.T) {
+	schema := `
+		type Query {
+			users(first: Int = 10, after: ID, order: Order = NAME, active: Boolean!): [User!]!
+		}
+
+		enum Order {
+			NAME
+			AGE
+		}
+
+		type User {
+			name: String!
+		}
+	`
+
+	fileMap, err := NewCodeGen(schema, config.Config{}).Generate()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, expected := range []string{
+		"// First defaults to 10 when not given\n\tFirst *int32\n",
+		"\tAfter *graphql.ID\n",
+		"// Order defaults to NAME when not given\n\tOrder ",
+		"\tActive bool\n",
+	} {
+		if !strings.Contains(fileMap["query_gen.go"], expected) {
+			t.Errorf("Expected %q in\n%s", expected, fileMap["query_gen.go"])
+		}
+	}
+	if strings.Count(fileMap["query_gen.go"], "defaults to") != 2 {
+		t.Errorf("Only the arguments with a default should mention it\n%s", fileMap["query_gen.go"])
+	}
+}
+
+func TestCamelCaseNames(t *testing.T) {
+	schema := `
+		type Query {
+			user_by_id(user_id: ID!): User
+		}
+
+		type User {
+			first_name: String!
+			avatar_url: String
+		}
+	`
+
+	fileMap, err := NewCodeGen(schema, config.Config{CamelCase: true}).Generate()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for file, expected := range map[string][]string{
+		"query_gen.go": {"UserByID(args *QueryUserByIDArgs) *UserResolver", "UserID graphql.ID"},
+		"user_gen.go":  {"FirstName string `json:\"first_name\"`", "AvatarURL *string `json:\"avatar_url,omitempty\"`", "FirstName() string"},
+	} {
+		for _, expected := range expected {
+			if !strings.Contains(fileMap[file], expected) {
+				t.Errorf("Expected %s in\n%s", expected, fileMap[file])
+			}
+		}
+	}
+}
+
+func TestTypePrefix(t *testing.T) {
+	schema := `
+		type Query {
+			user(role: Role): User
+		}
+
+		type User {
+			role: Role!
+			friends: [User!]!
+		}
+
+		enum Role {
+			ADMIN
+		}
+	`
+
+	conf := config.Config{TypePrefix: "Admin", GenerateSchema: true, GenerateRegistry: true}
+	fileMap, err := NewCodeGen(schema, conf).Generate()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for file, expected := range map[string][]string{
+		"adminquery_gen.go":    {"func (r *AdminResolver) User(args *AdminQueryUserArgs) *AdminUserResolver", "Role *AdminRole"},
+		"adminuser_gen.go":     {"type AdminUser struct", "Role AdminRole", "Friends []*AdminUserResolver", "func (r *AdminUserResolver) Friends() []*AdminUserResolver"},
+		"adminrole_gen.go":     {"type AdminRole string", "AdminRoleADMIN AdminRole"},
+		"adminresolver_gen.go": {"type AdminResolver struct", "func NewAdminResolver() *AdminResolver"},
+		"adminschema_gen.go":   {"const AdminSchema = "},
+	} {
+		for _, expected := range expected {
+			if !strings.Contains(fileMap[file], expected) {
+				t.Errorf("Expected %s in %s\n%s", expected, file, fileMap[file])
+			}
+		}
+	}
+
+	conf = config.Config{TypePrefix: "admin"}
+	if _, err := NewCodeGen(schema, conf).Generate(); err == nil {
+		t.Error("Expected an error for a prefix making the types unexported")
+	}
+}
+
+func TestRootInterfacesErrors(t *testing.T) {
+	schema := `
+		type Query {
+			user(id: ID!): User
+		}
+
+		type User {
+			id: ID!
+		}
+	`
+
+	for _, conf := range []config.Config{
+		{RootInterfaces: true, Mode: "client"},
+		{RootInterfaces: true, SeedResolvers: true},
+		{RootInterfaces: true, GenerateRegistry: true},
+		{RootInterfaces: true, Type: map[string]config.TypeConfig{
+			"Query": {Field: map[string]config.FieldConfig{
+				"user": {Template: map[string]map[string]interface{}{"http_resolver": {"url": `"https://example.com"`}}},
+			}},
+		}},
+	} {
+		if _, err := NewCodeGen(schema, conf).Generate(); err == nil {
+			t.Errorf("Expected an error for root_interfaces with %+v", conf)
+		}
+	}
+}
+
+func TestEmbedStruct(t *testing.T) {
+	schema := `
+		type Query {
+			user: User
+		}
+
+		type User {
+			name: String!
+		}
+	`
+
+	fileMap, err := NewCodeGen(schema, config.Config{EmbedStruct: "Deps"}).Generate()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for fileName, expected := range map[string]string{
+		"user_gen.go":     "type UserResolver struct {\n\tUser\n\t*Deps\n}",
+		"resolver_gen.go": "type Resolver struct {\n\t*Deps\n}",
+	} {
+		if !strings.Contains(fileMap[fileName], expected) {
+			t.Errorf("Expected %s in %s\n%s", expected, fileName, fileMap[fileName])
+		}
+	}
+
+	for _, conf := range []config.Config{
+		{EmbedStruct: "*Deps"},
+		{EmbedStruct: "deps.deps"},
+		{EmbedStruct: "Deps", EmbedImportPath: "example.com/deps"},
+		{EmbedImportPath: "example.com/deps"},
+		{EmbedStruct: "deps.Name", EmbedImportPath: "example.com/deps"},
+	} {
+		if _, err := NewCodeGen(schema, conf).Generate(); err == nil {
+			t.Errorf("Expected an error for embed_struct %q with embed_import_path %q", conf.EmbedStruct, conf.EmbedImportPath)
+		}
+	}
+
+	// The resolvers of other packages cannot refer to the struct of the generated package
+	conf := config.Config{
+		EmbedStruct: "Deps",
+		ImportPath:  "example.com/api",
+		Type:        map[string]config.TypeConfig{"User": {Package: "models"}},
+	}
+	if _, err := NewCodeGen(schema, conf).Generate(); err == nil || !strings.Contains(err.Error(), "type User is generated into package models") {
+		t.Errorf("Expected an error for embedding Deps into a resolver of another package, got %v", err)
+	}
+	conf.EmbedStruct, conf.EmbedImportPath = "deps.Deps", "example.com/deps"
+	fileMap, err = NewCodeGen(schema, conf).Generate()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := "type UserResolver struct {\n\tUser\n\t*deps.Deps\n}"; !strings.Contains(fileMap["models/user_gen.go"], expected) {
+		t.Errorf("Expected %s in models/user_gen.go\n%s", expected, fileMap["models/user_gen.go"])
+	}
+}
+
+func TestStringFieldErrors(t *testing.T) {
+	schema := `
+		type Query {
+			user: User
+		}
+
+		type User {
+			name: String!
+			friends: [User!]!
+			nick: String
+		}
+
+		enum Role {
+			ADMIN
+		}
+	`
+
+	for _, test := range []struct {
+		typeName string
+		typeConf config.TypeConfig
+	}{
+		{"User", config.TypeConfig{StringField: "nam"}},
+		{"User", config.TypeConfig{StringField: "friends"}},
+		{"User", config.TypeConfig{StringField: "nick", Field: map[string]config.FieldConfig{"nick": {Template: map[string]map[string]interface{}{"custom": {}}}}}},
+		{"Role", config.TypeConfig{StringField: "name"}},
+		{"Query", config.TypeConfig{StringField: "user"}},
+	} {
+		conf := config.Config{Type: map[string]config.TypeConfig{test.typeName: test.typeConf}}
+		if _, err := NewCodeGen(schema, conf).Generate(); err == nil {
+			t.Errorf("Expected an error for the string_field %s of %s", test.typeConf.StringField, test.typeName)
+		}
+	}
+}
+
+func TestTarget(t *testing.T) {
+	schema := `
+		type Query {
+			user: User
+		}
+
+		type User {
+			id: ID!
+		}
+	`
+
+	conf := config.Config{Target: "gqlgen", Scalar: map[string]config.ScalarConfig{"ID": {GoType: "int64"}}}
+	g := NewCodeGen(schema, conf)
+	fileMap, err := g.Generate()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if g.conf.Mode != "" {
+		t.Errorf("Generating should leave the config of the CodeGen as it is, got mode %q", g.conf.Mode)
+	}
+	if !strings.Contains(fileMap["user_gen.go"], "ID int64") {
+		t.Errorf("A scalar config of ID should be kept\n%s", fileMap["user_gen.go"])
+	}
+	if _, ok := fileMap["query_gen.go"]; ok {
+		t.Error("gqlgen models should not include the entry points")
+	}
+
+	for _, conf := range []config.Config{
+		{Target: "gqlgen", Mode: "client"},
+		{Target: "gqlgen2"},
+	} {
+		if _, err := NewCodeGen(schema, conf).Generate(); err == nil {
+			t.Errorf("Expected an error for target %s in mode %s", conf.Target, conf.Mode)
+		}
+	}
+}
+
+func TestCommentTerminators(t *testing.T) {
+	schema := `
+		type Query {
+			# Finds a user */ func init() { panic("injected") } /*
+			user: User
+		}
+
+		# A user */
+		type User {
+			# The name, like /* this */
+			name: String!
+		}
+	`
+
+	fileMap, err := NewCodeGen(schema, config.Config{}).Generate()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for file, expected := range map[string]string{
+		"query_gen.go": "// User Finds a user */ func init() { panic(\"injected\") } /*\n",
+		"user_gen.go":  "// User A user */\n",
+	} {
+		if !strings.Contains(fileMap[file], expected) {
+			t.Errorf("Expected %s in\n%s", expected, fileMap[file])
+		}
+	}
+}
+
+func TestEnumConfigErrors(t *testing.T) {
+	schema := `
+		type Query {
+			status: Status!
+		}
+
+		enum Status {
+			OPEN
+			IN_PROGRESS
+			DONE
+		}
+	`
+
+	for _, test := range []struct {
+		typeName string
+		typeConf config.TypeConfig
+	}{
+		{"Status", config.TypeConfig{Labels: map[string]string{"CLOSED": "Closed"}}},
+		{"Status", config.TypeConfig{Labels: map[string]string{"OPEN": "Done", "DONE": "Done"}}},
+		{"Status", config.TypeConfig{Labels: map[string]string{"IN_PROGRESS": "OPEN"}}},
+		{"Query", config.TypeConfig{Labels: map[string]string{"status": "Status"}}},
+		{"Query", config.TypeConfig{SQL: true}},
+	} {
+		conf := config.Config{Type: map[string]config.TypeConfig{test.typeName: test.typeConf}}
+		if _, err := NewCodeGen(schema, conf).Generate(); err == nil {
+			t.Errorf("Expected an error for the config %+v of %s", test.typeConf, test.typeName)
+		}
+	}
+}
+
+func TestGeneratePlan(t *testing.T) {
+	schema := `
+		scalar Time
+
+		type Query {
+			node: Node
+			now: Time!
+		}
+
+		interface Node {
+			id: ID!
+		}
+	`
+
+	entries, err := GeneratePlan(schema, config.Config{Mocks: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []PlanEntry{
+		{TypeName: "Node", Kind: "INTERFACE", FileName: "node_gen.go", Supported: true},
+		{TypeName: "Node", Kind: "INTERFACE", FileName: "node_mock_gen.go", Mock: true, Supported: true},
+		{TypeName: "Query", Kind: "OBJECT", FileName: "query_gen.go", Supported: true},
+		{TypeName: "Time", Kind: "SCALAR", FileName: "time_gen.go", Supported: false},
+		{TypeName: "Resolver", Kind: "RESOLVER", FileName: "resolver_gen.go", Supported: true},
+	}
+	if !reflect.DeepEqual(entries, expected) {
+		t.Errorf("Unexpected plan\n%+v\nexpected\n%+v", entries, expected)
+	}
+
+	fileMap, err := NewCodeGen(schema, config.Config{Mocks: true}).Generate()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(fileMap) != len(entries) {
+		t.Errorf("Generated %d files, planned %d", len(fileMap), len(entries))
+	}
+	for _, entry := range entries {
+		if _, ok := fileMap[entry.FileName]; !ok {
+			t.Errorf("Planned file %s was not generated", entry.FileName)
+		}
+	}
+
+	if _, err := GeneratePlan(schema, config.Config{Mode: "mock"}); err == nil {
+		t.Error("Plan should validate the config")
+	}
+
+	if _, err := NewCodeGen(schema, config.Config{StrictUnsupported: true}).Generate(); err == nil || !strings.Contains(err.Error(), "Time") {
+		t.Errorf("strict_unsupported should reject the Time scalar, got %v", err)
+	}
+	conf := config.Config{
+		StrictUnsupported: true,
+		Scalar:            map[string]config.ScalarConfig{"Time": {GoType: "time.Time", ImportPath: "time"}},
+	}
+	if _, err := NewCodeGen(schema, conf).Generate(); err != nil {
+		t.Errorf("Mapped scalars should be supported, got %v", err)
+	}
+}
+
+func TestFieldOrder(t *testing.T) {
+	schema := `
+		type Query {
+			user: User
+		}
+
+		type User {
+			zip: String
+			name: String!
+			age: Int
+		}
+
+		input UserInput {
+			zip: String
+			name: String!
+			age: Int
+		}
+
+		enum Role {
+			VIEWER
+			ADMIN
+			EDITOR
+		}
+	`
+
+	for i := 0; i < 3; i++ {
+		fileMap, err := NewCodeGen(schema, config.Config{}).Generate()
+		if err != nil {
+			t.Fatal(err)
+		}
+		for file, names := range map[string][]string{
+			"user_gen.go":      {"Zip *string", "Name string", "Age *int32", "Zip() *string", "Name() string", "Age() *int32"},
+			"userinput_gen.go": {"Zip *string", "Name string", "Age *int32"},
+			"role_gen.go":      {"RoleVIEWER", "RoleADMIN", "RoleEDITOR"},
+		} {
+			last := -1
+			for _, name := range names {
+				index := strings.Index(fileMap[file], name)
+				if index <= last {
+					t.Fatalf("Expected the SDL order %q in\n%s", names, fileMap[file])
+				}
+				last = index
+			}
+		}
+	}
+}
+
+func TestJSONTags(t *testing.T) {
+	schema := `
+		type Query {
+			user: User
+		}
+
+		type User {
+			firstName: String!
+			lastName: String
+		}
+
+		input UserInput {
+			firstName: String!
+		}
+	`
+
+	for _, mode := range []string{"server", "models"} {
+		fileMap, err := NewCodeGen(schema, config.Config{Mode: mode}).Generate()
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, file := range []string{"user_gen.go", "userinput_gen.go"} {
+			if !strings.Contains(fileMap[file], "FirstName string `json:\"firstName\"`") {
+				t.Errorf("Expected the schema name as json tag in %s mode\n%s", mode, fileMap[file])
+			}
+		}
+	}
+
+	for omitEmpty, expected := range map[string]string{
+		"":         "LastName *string `json:\"lastName,omitempty\"`",
+		"nullable": "FirstName string `json:\"firstName\"`",
+		"always":   "FirstName string `json:\"firstName,omitempty\"`",
+		"never":    "LastName *string `json:\"lastName\"`",
+	} {
+		fileMap, err := NewCodeGen(schema, config.Config{OmitEmpty: omitEmpty}).Generate()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !strings.Contains(fileMap["user_gen.go"], expected) {
+			t.Errorf("Expected %s with omit_empty %q\n%s", expected, omitEmpty, fileMap["user_gen.go"])
+		}
+	}
+	if _, err := NewCodeGen(schema, config.Config{OmitEmpty: "sometimes"}).Generate(); err == nil {
+		t.Error("Unknown omit_empty should be rejected")
+	}
+
+	jsonTags := false
+	fileMap, err := NewCodeGen(schema, config.Config{JSONTags: &jsonTags}).Generate()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, file := range []string{"user_gen.go", "userinput_gen.go"} {
+		if strings.Contains(fileMap[file], "`json:") {
+			t.Errorf("json_tags = false should leave out the tags\n%s", fileMap[file])
+		}
+	}
+}
+
+func TestIgnore(t *testing.T) {
+	schema := `
+		type Query {
+			user: User
+		}
+
+		type User {
+			name: String!
+		}
+
+		type CreateUserPayload {
+			user: User
+		}
+
+		type DeleteUserPayload {
+			id: ID!
+		}
+
+		type VendorAccount {
+			id: ID!
+		}
+	`
+
+	conf := config.Config{Ignore: []string{"*Payload", "VendorAccount"}}
+	fileMap, err := NewCodeGen(schema, conf).Generate()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, fileName := range []string{"createuserpayload_gen.go", "deleteuserpayload_gen.go", "vendoraccount_gen.go"} {
+		if _, ok := fileMap[fileName]; ok {
+			t.Errorf("%s should have been ignored", fileName)
+		}
+	}
+	for _, fileName := range []string{"query_gen.go", "user_gen.go", "resolver_gen.go"} {
+		if _, ok := fileMap[fileName]; !ok {
+			t.Errorf("%s should have been generated", fileName)
+		}
+	}
+
+	if _, err := NewCodeGen(schema, config.Config{Ignore: []string{"[Payload"}}).Generate(); err == nil {
+		t.Error("Invalid ignore pattern should be rejected")
+	}
+}
+
+func TestGoName(t *testing.T) {
+	schema := `
+		type Query {
+			stringer: Stringer
+		}
+
+		type Stringer {
+			value: String!
+			parts: [Stringer!]
+		}
+
+		type StringValue {
+			value: String!
+		}
+	`
+
+	conf := config.Config{
+		Type: map[string]config.TypeConfig{"Stringer": config.TypeConfig{GoName: "Text"}},
+	}
+	fileMap, err := NewCodeGen(schema, conf).Generate()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(fileMap["text_gen.go"], "type Text struct") || !strings.Contains(fileMap["text_gen.go"], "Parts *[]*TextResolver") {
+		t.Errorf("Stringer should be generated as Text\n%s", fileMap["text_gen.go"])
+	}
+	if !strings.Contains(fileMap["query_gen.go"], "*TextResolver") {
+		t.Errorf("Query should reference Stringer as Text\n%s", fileMap["query_gen.go"])
+	}
+
+	for _, types := range []map[string]config.TypeConfig{
+		{"Stringer": config.TypeConfig{GoName: "StringValue"}},
+		{"Stringer": config.TypeConfig{GoName: "func"}},
+		{"Query": config.TypeConfig{GoName: "Root"}},
+	} {
+		if _, err := NewCodeGen(schema, config.Config{Type: types}).Generate(); err == nil {
+			t.Errorf("Type config %+v should be rejected", types)
+		}
+	}
+}
+
+func TestFieldGoName(t *testing.T) {
+	schema := `
+		type Link {
+			id_str: String!
+			url: String!
+		}
+	`
+
+	conf := config.Config{
+		Type: map[string]config.TypeConfig{
+			"Link": config.TypeConfig{
+				Field: map[string]config.FieldConfig{"id_str": config.FieldConfig{GoName: "IDStr"}},
+			},
+		},
+	}
+	fileMap, err := NewCodeGen(schema, conf).Generate()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	code := fileMap["link_gen.go"]
+	for _, expected := range []string{
+		"IDStr string `json:\"id_str\"`",
+		"func (r *LinkResolver) IDStr() string {\n\treturn r.Link.IDStr\n}",
+		"Url string `json:\"url\"`",
+		"func (r *LinkResolver) Url() string {\n\treturn r.Link.Url\n}",
+	} {
+		if !strings.Contains(code, expected) {
+			t.Errorf("Generated code should contain %q\n%s", expected, code)
+		}
+	}
+
+	conf.Type["Link"].Field["id_str"] = config.FieldConfig{GoName: "idStr"}
+	if _, err := NewCodeGen(schema, conf).Generate(); err == nil {
+		t.Error("Unexported go_name should be rejected")
+	}
+
+	// graphql-go would look for a method named like idstr
+	conf.Type["Link"].Field["id_str"] = config.FieldConfig{GoName: "IDString"}
+	if _, err := NewCodeGen(schema, conf).Generate(); err == nil || !strings.Contains(err.Error(), "ignoring case and underscores") {
+		t.Errorf("Expected an error for a go_name graphql-go cannot bind, got %v", err)
+	}
+
+	// Models have no resolvers to bind
+	conf.Mode = "models"
+	fileMap, err = NewCodeGen(schema, conf).Generate()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(fileMap["link_gen.go"], "IDString string `json:\"id_str\"`") {
+		t.Errorf("Expected the renamed struct field of the model\n%s", fileMap["link_gen.go"])
+	}
+}
+
+func TestCapitalise(t *testing.T) {
+	tests := []struct {
+		in            string
+		capitalised   string
+		uncapitalised string
+	}{
+		{"", "", ""},
+		{"id", "ID", "id"},
+		{"name", "Name", "name"},
+		{"Name", "Name", "name"},
+		{"_name", "Name", "_name"},
+		{"__typename", "Typename", "__typename"},
+		{"_", "_", "_"},
+		{"type", "Type", "type_"},
+		{"Range", "Range", "range_"},
+		{"Func", "Func", "func_"},
+		{"élan", "Élan", "élan"},
+		{"Ωmega", "Ωmega", "ωmega"},
+		{"日本", "日本", "日本"},
+	}
+
+	g := NewCodeGen("", config.Config{})
+	for _, test := range tests {
+		if result := g.capitalise(test.in); result != test.capitalised {
+			t.Errorf("capitalise(%q) = %q, expected %q", test.in, result, test.capitalised)
+		}
+		if result := g.unCapitalise(test.in); result != test.uncapitalised {
+			t.Errorf("unCapitalise(%q) = %q, expected %q", test.in, result, test.uncapitalised)
+		}
+	}
+}
+
+func TestGodoc(t *testing.T) {
+	tests := []struct {
+		parts    []string
+		expected string
+	}{
+		{[]string{}, ""},
+		{[]string{"", ""}, ""},
+		{[]string{"Name", ""}, "// Name"},
+		{[]string{"Name", "of the user"}, "// Name of the user"},
+		{[]string{"Name", "first line\nsecond line"}, "// Name first line\n// second line"},
+		{[]string{"Name", "paragraph\n\nnext"}, "// Name paragraph\n//\n// next"},
+		{[]string{"Name", "ends */ a /* block"}, "// Name ends */ a /* block"},
+		{[]string{"Name", "windows\r\nline"}, "// Name windows\n// line"},
+		{[]string{"Name", "nul\x00 and\x1b escape"}, "// Name nul  and  escape"},
+	}
+
+	g := NewCodeGen("", config.Config{})
+	for _, test := range tests {
+		if result := g.godoc(test.parts...); result != test.expected {
+			t.Errorf("godoc(%q) = %q, expected %q", test.parts, result, test.expected)
+		}
+	}
+}
+
+func TestImportBlock(t *testing.T) {
+	tests := []struct {
+		imports  []string
+		expected string
+	}{
+		{nil, ""},
+		{[]string{"", " "}, ""},
+		{[]string{`"fmt"`}, "import (\n\t\"fmt\"\n)\n"},
+		{
+			[]string{`graphql "github.com/neelance/graphql-go"`, "", `"time"`, `"context"`, `"time"`},
+			"import (\n\t\"context\"\n\t\"time\"\n\n\tgraphql \"github.com/neelance/graphql-go\"\n)\n",
+		},
+		{
+			[]string{`models "example.com/api/models"`, `inputs "example.com/api/inputs"`, `sql "database/sql"`},
+			"import (\n\tsql \"database/sql\"\n\n\tinputs \"example.com/api/inputs\"\n\tmodels \"example.com/api/models\"\n)\n",
+		},
+	}
+
+	g := NewCodeGen("", config.Config{})
+	for _, test := range tests {
+		if result := g.importBlock(test.imports); result != test.expected {
+			t.Errorf("importBlock(%q) = %q, expected %q", test.imports, result, test.expected)
+		}
+	}
+}
+
+func TestBuiltinScalarImports(t *testing.T) {
+	schema := `
+		type User {
+			name: String
+			age: Int!
+			score: Float
+			active: Boolean!
+		}
+	`
+
+	sch, err := graphql.ParseSchema(schema, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var user *introspection.Type
+	for _, tp := range sch.Inspect().Types() {
+		if *tp.Name() == "User" {
+			user = tp
+		}
+	}
+
+	g := NewCodeGen(schema, config.Config{})
+	imports := []string{}
+	for _, fp := range schemaFields(t, schema, "User") {
+		_, _, fieldImports, err := g.generateField(fp, user, config.TypeConfig{}, config.Config{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		imports = append(imports, fieldImports...)
+	}
+
+	if imports = g.removeDuplicates(imports); len(imports) != 0 {
+		t.Errorf("Built-in scalar fields should not need imports, got %q", imports)
+	}
+}
+
+func TestWrappedScalarImports(t *testing.T) {
+	schema := `
+		scalar Time
+
+		type Query {
+			events(after: [Time!]!): [Event!]!
+		}
+
+		type Event {
+			at: Time!
+			times: [Time!]!
+			schedule: [[Time!]!]
+		}
+
+		input EventFilter {
+			between: [Time!]!
+		}
+	`
+
+	conf := config.Config{
+		Scalar: map[string]config.ScalarConfig{"Time": {GoType: "time.Time", ImportPath: "time"}},
+	}
+
+	g := NewCodeGen(schema, conf)
+	for _, fp := range schemaFields(t, schema, "Event") {
+		if imports := g.getImports(fp.Type(), conf); !reflect.DeepEqual(imports, []string{`"time"`}) {
+			t.Errorf("Expected the time import for %s, got %q", fp.Name(), imports)
+		}
+	}
+
+	fileMap, err := NewCodeGen(schema, conf).Generate()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, file := range []string{"query_gen.go", "event_gen.go", "eventfilter_gen.go"} {
+		if !strings.Contains(fileMap[file], `"time"`) {
+			t.Errorf("Expected the time import in %s\n%s", file, fileMap[file])
+		}
+	}
+}
+
+func TestScalarAlias(t *testing.T) {
+	schema := `
+		scalar Money
+		scalar Weight
+
+		type Query {
+			price: Money!
+			weights: [Weight!]
+		}
+	`
+
+	conf := config.Config{
+		Scalar: map[string]config.ScalarConfig{
+			"Money":  {GoType: "types.Money", ImportPath: "github.com/example/billing/types", Alias: "billing"},
+			"Weight": {GoType: "*types.Weight", ImportPath: "github.com/example/shipping/types", Alias: "shipping"},
+		},
+	}
+	fileMap, err := NewCodeGen(schema, conf).Generate()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, expected := range []string{
+		`billing "github.com/example/billing/types"`,
+		`shipping "github.com/example/shipping/types"`,
+		"Price() billing.Money",
+		"Weights() *[]*shipping.Weight",
+	} {
+		if !strings.Contains(fileMap["query_gen.go"], expected) {
+			t.Errorf("Expected %s in\n%s", expected, fileMap["query_gen.go"])
+		}
+	}
+
+	for _, scalar := range []config.ScalarConfig{
+		{GoType: "types.Money", ImportPath: "github.com/example/billing/types", Alias: "billing-types"},
+		{GoType: "Money", ImportPath: "github.com/example/billing/types", Alias: "billing"},
+		{GoType: "types.Money", Alias: "billing"},
+	} {
+		conf := config.Config{Scalar: map[string]config.ScalarConfig{"Money": scalar}}
+		if _, err := NewCodeGen(schema, conf).Generate(); err == nil {
+			t.Errorf("Expected an error for %+v", scalar)
+		}
+	}
+}
+
+func TestScalarImportName(t *testing.T) {
+	schema := `
+		scalar UUID
+
+		type Query {
+			user(id: UUID!): String
+		}
+	`
+	conf := config.Config{Scalar: map[string]config.ScalarConfig{
+		"UUID": {GoType: "uuid.UUID", ImportPath: "github.com/satori/go.uuid"},
+	}}
+	fileMap, err := NewCodeGen(schema, conf).Generate()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if query := fileMap["query_gen.go"]; !strings.Contains(query, "uuid \"github.com/satori/go.uuid\"") || !strings.Contains(query, "ID uuid.UUID") {
+		t.Errorf("Expected go.uuid to be imported with the name of uuid.UUID\n%s", query)
+	}
+}
+
+func TestScalarAssertions(t *testing.T) {
+	schema := `
+		scalar Money
+		scalar DateTime
+
+		type Query {
+			price: Money
+			now: DateTime
+		}
+	`
+
+	conf := config.Config{Scalar: map[string]config.ScalarConfig{
+		"Money":    {GoType: "types.Money", ImportPath: "github.com/example/billing/types", Alias: "billing", Assert: true},
+		"DateTime": {GoType: "time.Time", ImportPath: "time"},
+	}}
+	fileMap, err := NewCodeGen(schema, conf).Generate()
+	if err != nil {
+		t.Fatal(err)
+	}
+	code := fileMap["resolver_gen.go"]
+	for _, expected := range []string{
+		"billing \"github.com/example/billing/types\"",
+		"} = (*billing.Money)(nil)",
+	} {
+		if !strings.Contains(code, expected) {
+			t.Errorf("Expected %s in\n%s", expected, code)
+		}
+	}
+	if strings.Contains(code, "time.Time") {
+		t.Errorf("Scalars without assert should not be asserted\n%s", code)
+	}
+
+	conf.Scalar["ID"] = config.ScalarConfig{GoType: "string", Assert: true}
+	if _, err := NewCodeGen(schema, conf).Generate(); err == nil {
+		t.Error("Expected an error for asserting the built-in scalar ID")
+	}
+
+	delete(conf.Scalar, "ID")
+	for _, mode := range []string{"client", "models"} {
+		conf.Mode = mode
+		if _, err := NewCodeGen(schema, conf).Generate(); err == nil || !strings.Contains(err.Error(), "assert on scalar Money") {
+			t.Errorf("Expected an error for asserting a scalar in the %s mode, got %v", mode, err)
+		}
+	}
+}
+
+func TestIDScalar(t *testing.T) {
+	schema := `
+		type Query {
+			user(id: ID!): User
+		}
+
+		type User {
+			id: ID!
+			friendIds: [ID!]
+		}
+	`
+
+	conf := config.Config{Scalar: map[string]config.ScalarConfig{"ID": {GoType: "string"}}}
+	fileMap, err := NewCodeGen(schema, conf).Generate()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, file := range []string{"query_gen.go", "user_gen.go"} {
+		if strings.Contains(fileMap[file], "github.com/neelance/graphql-go") || strings.Contains(fileMap[file], "graphql.ID") {
+			t.Errorf("IDs mapped to string should not need graphql-go\n%s", fileMap[file])
+		}
+	}
+	for _, expected := range []string{"ID string", "FriendIds *[]string"} {
+		if !strings.Contains(fileMap["user_gen.go"], expected) {
+			t.Errorf("Expected %s in\n%s", expected, fileMap["user_gen.go"])
+		}
+	}
+	if !strings.Contains(fileMap["query_gen.go"], "ID string") {
+		t.Errorf("Expected the argument as a string\n%s", fileMap["query_gen.go"])
+	}
+}
+
+func TestTypeDescription(t *testing.T) {
+	schema := `
+		type Query {
+			user: User
+			group: Group
+		}
+
+		# A user of the service
+		# with an account
+		type User {
+			id: ID!
+		}
+
+		type Group {
+			id: ID!
+		}
+	`
+
+	fileMap, err := NewCodeGen(schema, config.Config{}).Generate()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(fileMap["user_gen.go"], "// User A user of the service\n// with an account\ntype User struct") {
+		t.Errorf("Expected the description as the doc comment\n%s", fileMap["user_gen.go"])
+	}
+	if strings.Contains(fileMap["group_gen.go"], "// Group\n") {
+		t.Errorf("Types without a description should not get a doc comment\n%s", fileMap["group_gen.go"])
+	}
+}
+
+func TestAnnotateSource(t *testing.T) {
+	schema := `
+		type Query {
+			hero: Character
+		}
+
+		interface Character {
+			name: String!
+		}
+
+		# A humanoid creature
+		type Human implements Character {
+			name: String!
+			# The friends of the human
+			friends: [Character!]! @deprecated(reason: "Use connections")
+		}
+	`
+
+	fileMap, err := NewCodeGen(schema, config.Config{}).Generate()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(fileMap["human_gen.go"], "source:") {
+		t.Errorf("Expected no source comments by default\n%s", fileMap["human_gen.go"])
+	}
+
+	fileMap, err = NewCodeGen(schema, config.Config{AnnotateSource: true}).Generate()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for fileName, expected := range map[string]string{
+		"human_gen.go":     "// Human A humanoid creature\n// source: Human\ntype Human struct",
+		"character_gen.go": "// source: Character\ntype Character interface",
+		"query_gen.go":     "// source: Query.hero\nfunc (r *Resolver) Hero()",
+	} {
+		if !strings.Contains(fileMap[fileName], expected) {
+			t.Errorf("Expected %s to contain\n%s\n\n%s", fileName, expected, fileMap[fileName])
+		}
+	}
+	expected := "// Friends The friends of the human\n// source: Human.friends\n//\n// Deprecated: Use connections\n"
+	if !strings.Contains(fileMap["human_gen.go"], expected) {
+		t.Errorf("Expected the source before the deprecation\n%s", fileMap["human_gen.go"])
+	}
+}
+
+func TestDeprecationReason(t *testing.T) {
+	schema := `
+		type User {
+			name: String!
+			login: String! @deprecated(reason: "Use name,\nthe login is\r\n\tgoing away")
+			nick: String! @deprecated(reason: " \n ")
+		}
+	`
+
+	expected := map[string]string{
+		"name":  "",
+		"login": "Use name, the login is going away",
+		"nick":  "No longer supported",
+	}
+
+	g := NewCodeGen(schema, config.Config{})
+	for _, fp := range schemaFields(t, schema, "User") {
+		if reason := g.deprecationReason(fp); reason != expected[fp.Name()] {
+			t.Errorf("Field %s deprecation reason %q, expected %q", fp.Name(), reason, expected[fp.Name()])
+		}
+	}
+
+	fileMap, err := g.Generate()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(fileMap["user_gen.go"], "// Deprecated: Use name, the login is going away\n") {
+		t.Errorf("Deprecation comment should be on one line\n%s", fileMap["user_gen.go"])
+	}
+}
+
+func TestKeywordSuffix(t *testing.T) {
+	g := NewCodeGen("", config.Config{KeywordSuffix: "Value"})
+	if result := g.unCapitalise("Type"); result != "typeValue" {
+		t.Errorf("unCapitalise(%q) = %q, expected %q", "Type", result, "typeValue")
+	}
+	if result := g.unCapitalise("Name"); result != "name" {
+		t.Errorf("unCapitalise(%q) = %q, expected %q", "Name", result, "name")
+	}
+}
+
+func TestGetTypeName(t *testing.T) {
+	schema := `
+		type Item {
+			id: ID!
+		}
+
+		type Lists {
+			nullableList: [Int]
+			nonNullItems: [Int!]
+			nonNullList: [Int!]!
+			listOfLists: [[Int!]!]!
+			nullableListOfLists: [[Int!]!]
+			nullableNested: [[Int]]
+			objects: [Item]
+			nonNullObjects: [Item!]!
+		}
+	`
+
+	expected := map[string]string{
+		"nullableList":        "*[]*int32",
+		"nonNullItems":        "*[]int32",
+		"nonNullList":         "[]int32",
+		"listOfLists":         "[][]int32",
+		"nullableListOfLists": "*[][]int32",
+		"nullableNested":      "*[]*[]*int32",
+		"objects":             "*[]*ItemResolver",
+		"nonNullObjects":      "[]*ItemResolver",
+	}
+
+	g := NewCodeGen(schema, config.Config{})
+	for _, fp := range schemaFields(t, schema, "Lists") {
+		if typeName := g.getTypeName(fp.Type(), config.Config{}, false); typeName != expected[fp.Name()] {
+			t.Errorf("Field %s type %s, expected %s", fp.Name(), typeName, expected[fp.Name()])
+		}
+	}
+}
+
+func TestGetTypeNameListNullability(t *testing.T) {
+	schema := `
+		type Item {
+			id: ID!
+		}
+
+		enum Color {
+			RED
+		}
+
+		input Filter {
+			id: ID
+		}
+
+		type Lists {
+			scalars(a: [Filter], b: [Filter!], c: [Filter]!, d: [Filter!]!, e: Filter, f: Filter!): [Int]
+			scalarsNonNullItems: [Int!]
+			scalarsNonNullList: [Int]!
+			scalarsNonNull: [Int!]!
+			objects: [Item]
+			objectsNonNullItems: [Item!]
+			objectsNonNullList: [Item]!
+			objectsNonNull: [Item!]!
+			enums: [Color]
+			enumsNonNullItems: [Color!]
+			enumsNonNullList: [Color]!
+			enumsNonNull: [Color!]!
+			nested: [[Item!]]!
+		}
+	`
+
+	// graphql-go resolves null lists from nil pointers to slices, and null
+	// elements from nil pointers, so each nullable level is a pointer
+	expected := map[string]string{
+		"scalars":             "*[]*int32",
+		"scalarsNonNullItems": "*[]int32",
+		"scalarsNonNullList":  "[]*int32",
+		"scalarsNonNull":      "[]int32",
+		"objects":             "*[]*ItemResolver",
+		"objectsNonNullItems": "*[]*ItemResolver",
+		"objectsNonNullList":  "[]*ItemResolver",
+		"objectsNonNull":      "[]*ItemResolver",
+		"enums":               "*[]*Color",
+		"enumsNonNullItems":   "*[]Color",
+		"enumsNonNullList":    "[]*Color",
+		"enumsNonNull":        "[]Color",
+		"nested":              "[]*[]*ItemResolver",
+	}
+	expectedArgs := map[string]string{
+		"a": "*[]*Filter",
+		"b": "*[]Filter",
+		"c": "[]*Filter",
+		"d": "[]Filter",
+		"e": "*Filter",
+		"f": "*Filter",
+	}
+
+	g := NewCodeGen(schema, config.Config{})
+	for _, fp := range schemaFields(t, schema, "Lists") {
+		if typeName := g.getTypeName(fp.Type(), config.Config{}, false); typeName != expected[fp.Name()] {
+			t.Errorf("Field %s type %s, expected %s", fp.Name(), typeName, expected[fp.Name()])
+		}
+		for _, arg := range fp.Args() {
+			if typeName := g.getTypeName(arg.Type(), config.Config{}, true); typeName != expectedArgs[arg.Name()] {
+				t.Errorf("Argument %s type %s, expected %s", arg.Name(), typeName, expectedArgs[arg.Name()])
+			}
+		}
+	}
+}
+
+func TestResolverSuffix(t *testing.T) {
+	schema := `
+		type Item {
+			id: ID!
+		}
+
+		type Lists {
+			item: Item!
+			items: [Item]
+		}
+	`
+
+	for suffix, expected := range map[string][]string{
+		"Impl": {"*ItemImpl", "*[]*ItemImpl"},
+		"":     {"*Item", "*[]*Item"},
+	} {
+		suffix := suffix
+		conf := config.Config{ResolverSuffix: &suffix}
+		g := NewCodeGen(schema, conf)
+		for i, fp := range schemaFields(t, schema, "Lists") {
+			if typeName := g.getTypeName(fp.Type(), conf, false); typeName != expected[i] {
+				t.Errorf("Field %s with suffix %q type %s, expected %s", fp.Name(), suffix, typeName, expected[i])
+			}
+		}
+	}
+}
+
+func schemaFields(t *testing.T, schema string, typeName string) []*introspection.Field {
+	sch, err := graphql.ParseSchema(schema, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, tp := range sch.Inspect().Types() {
+		if *tp.Name() == typeName {
+			return *tp.Fields(&struct{ IncludeDeprecated bool }{true})
+		}
+	}
+
+	t.Fatalf("Type %s not found", typeName)
+	return nil
+}
+
+func TestGenerateWorkers(t *testing.T) {
+	schema, err := ioutil.ReadFile(path.Join(fixtureDir, "starwars", "schema.graphql"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	serial, err := NewCodeGen(string(schema), config.Config{Workers: 1}).Generate()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	concurrent, err := NewCodeGen(string(schema), config.Config{Workers: 8}).Generate()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(serial, concurrent) {
+		t.Error("Concurrent generation should produce the same files as serial generation")
+	}
+}
+
+func TestLogger(t *testing.T) {
+	schema := `
+		type Query {
+			hello: String!
+		}
+	`
+
+	var messages []string
+	conf := config.Config{
+		Workers: 1,
+		Logger: func(format string, args ...interface{}) {
+			messages = append(messages, fmt.Sprintf(format, args...))
+		},
+	}
+	if _, err := NewCodeGen(schema, conf).Generate(); err != nil {
+		t.Fatal(err)
+	}
+	if len(messages) != 1 || messages[0] != "Generating Go code for OBJECT Query" {
+		t.Errorf("Unexpected log messages %q", messages)
+	}
+}
+
+func TestGenerateFunc(t *testing.T) {
+	schema, err := ioutil.ReadFile(path.Join(fixtureDir, "mocks", "schema.graphql"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	conf := config.Config{Mocks: true, Workers: 4}
+
+	plan, err := GeneratePlan(string(schema), conf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected, err := NewCodeGen(string(schema), conf).Generate()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var fileNames []string
+	err = GenerateFunc(string(schema), conf, func(fileName string, r io.Reader) error {
+		code, err := ioutil.ReadAll(r)
+		if err != nil {
+			return err
+		}
+		if string(code) != expected[fileName] {
+			t.Errorf("%s differs from the file generated by Generate", fileName)
+		}
+		fileNames = append(fileNames, fileName)
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, entry := range plan {
+		if i >= len(fileNames) || fileNames[i] != entry.FileName {
+			t.Fatalf("Expected the files in the order of the plan, got %q", fileNames)
+		}
+	}
+
+	emitErr := errors.New("disk full")
+	calls := 0
+	err = GenerateFunc(string(schema), conf, func(fileName string, r io.Reader) error {
+		calls++
+		return emitErr
+	})
+	if err != emitErr || calls != 1 {
+		t.Errorf("An emit error should stop the generation, got %v after %d calls", err, calls)
+	}
+}
+
+func TestPostProcess(t *testing.T) {
+	schema := `
+		type Query {
+			user: User
+		}
+
+		type User {
+			name: String!
+		}
+	`
+
+	var processed []string
+	conf := config.Config{
+		Workers: 1,
+		PostProcess: func(fileName string, code []byte) ([]byte, error) {
+			processed = append(processed, fileName)
+			return append([]byte("// Copyright Example Inc.\n\n"), code...), nil
+		},
+	}
+	fileMap, err := NewCodeGen(schema, conf).Generate()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(processed) != len(fileMap) {
+		t.Errorf("Expected every file to be post processed once, got %v", processed)
+	}
+	for fileName, code := range fileMap {
+		if !strings.HasPrefix(code, "// Copyright Example Inc.\n\n// Code generated by graphql-codegen") {
+			t.Errorf("Expected the post processed code in %s\n%s", fileName, code)
+		}
+	}
+
+	conf.PostProcess = func(fileName string, code []byte) ([]byte, error) {
+		if fileName == "user_gen.go" {
+			return nil, errors.New("license check failed")
+		}
+		return code, nil
+	}
+	if _, err := NewCodeGen(schema, conf).Generate(); err == nil || !strings.Contains(err.Error(), "user_gen.go: license check failed") {
+		t.Errorf("Expected the error of the hook with the file name, got %v", err)
+	}
+}
+
+func TestGenerateWorkerError(t *testing.T) {
+	schema := `
+		type A {
+			name: String!
+		}
+
+		type B {
+			name: String!
+		}
+	`
+
+	conf := config.Config{
+		Workers: 2,
+		Type: map[string]config.TypeConfig{
+			"B": config.TypeConfig{
+				Template: map[string]map[string]interface{}{"missing": nil},
+			},
+		},
+	}
+
+	if _, err := NewCodeGen(schema, conf).Generate(); err == nil {
+		t.Error("Expected the error from the failing type to be returned")
+	}
+}
+
+func TestGenerateErrorLocation(t *testing.T) {
+	schema := `
+		type Human {
+			name: String!
+			friends: [Human!]!
+		}
+	`
+
+	conf := config.Config{
+		Type: map[string]config.TypeConfig{
+			"Human": config.TypeConfig{
+				Field: map[string]config.FieldConfig{
+					"friends": config.FieldConfig{
+						Template: map[string]map[string]interface{}{"missing": nil},
+					},
+				},
+			},
+		},
+	}
+
+	_, err := NewCodeGen(schema, conf).Generate()
+	var generateErr *GenerateError
+	if !errors.As(err, &generateErr) {
+		t.Fatalf("Expected a GenerateError, got %v", err)
+	}
+
+	if generateErr.TypeName != "Human" || generateErr.FieldName != "friends" || generateErr.Template != "missing" {
+		t.Errorf("Error %+v should point to the missing template on Human.friends", generateErr)
+	}
+	if !strings.Contains(err.Error(), "Human.friends") {
+		t.Errorf("Error %q should mention the field", err)
+	}
+	if errors.Unwrap(err) == nil {
+		t.Error("GenerateError should wrap the template error")
+	}
+}
+
+func TestNullStrategyErrors(t *testing.T) {
+	schema := `
+		scalar Time
+
+		type Event {
+			at: Time
+			name: String
+		}
+	`
+
+	for field, strategy := range map[string]string{"name": "nullable", "at": "sqlNull"} {
+		conf := config.Config{
+			Type: map[string]config.TypeConfig{
+				"Event": config.TypeConfig{
+					Field: map[string]config.FieldConfig{
+						field: config.FieldConfig{NullStrategy: strategy},
+					},
+				},
+			},
+		}
+
+		_, err := NewCodeGen(schema, conf).Generate()
+		var generateErr *GenerateError
+		if !errors.As(err, &generateErr) || generateErr.FieldName != field {
+			t.Errorf("Null strategy %q on Event.%s should fail, got %v", strategy, field, err)
+		}
+	}
+}
+
+func TestFieldGoType(t *testing.T) {
+	schema := `
+		type Query {
+			link: Link
+		}
+
+		type Link {
+			title: String!
+			href: String!
+		}
+	`
+
+	conf := config.Config{
+		Type: map[string]config.TypeConfig{
+			"Link": {
+				Field: map[string]config.FieldConfig{
+					"href": {GoType: "*url.URL", ImportPath: "net/url"},
+				},
+			},
+		},
+	}
+	fileMap, err := NewCodeGen(schema, conf).Generate()
+	if err != nil {
+		t.Fatal(err)
+	}
+	code := fileMap["link_gen.go"]
+	for _, expected := range []string{
+		"\t\"net/url\"\n",
+		"Href *url.URL `json:\"href\"`",
+		"func (r *LinkResolver) Href() *url.URL {",
+		"func (r *LinkResolver) Title() string {",
+	} {
+		if !strings.Contains(code, expected) {
+			t.Errorf("Expected the go_type of href to be used, missing %q\n%s", expected, code)
+		}
+	}
+
+	for _, fieldConf := range []config.FieldConfig{
+		{ImportPath: "net/url"},
+		{GoType: "*url.URL", ImportPath: "net/url", NullStrategy: "zero"},
+	} {
+		conf.Type["Link"].Field["href"] = fieldConf
+		var generateErr *GenerateError
+		if _, err := NewCodeGen(schema, conf).Generate(); !errors.As(err, &generateErr) || generateErr.FieldName != "href" {
+			t.Errorf("Field config %+v should fail, got %v", fieldConf, err)
+		}
+	}
+}
+
+func TestInputFieldGoType(t *testing.T) {
+	schema := `
+		type Query {
+			links(filter: LinkFilter): [String!]!
+		}
+
+		input LinkFilter {
+			title: String
+			href: String!
+		}
+	`
+
+	conf := config.Config{
+		Type: map[string]config.TypeConfig{
+			"LinkFilter": {
+				Field: map[string]config.FieldConfig{
+					"href": {GoType: "url.URL", ImportPath: "net/url"},
+				},
+			},
+		},
+	}
+	fileMap, err := NewCodeGen(schema, conf).Generate()
+	if err != nil {
+		t.Fatal(err)
+	}
+	code := fileMap["linkfilter_gen.go"]
+	for _, expected := range []string{"\t\"net/url\"\n", "Href url.URL `json:\"href\"`", "Title *string `json:\"title,omitempty\"`"} {
+		if !strings.Contains(code, expected) {
+			t.Errorf("Expected the go_type of href to be used, missing %q\n%s", expected, code)
+		}
+	}
+
+	conf.Type["LinkFilter"].Field["href"] = config.FieldConfig{ImportPath: "net/url"}
+	var generateErr *GenerateError
+	if _, err := NewCodeGen(schema, conf).Generate(); !errors.As(err, &generateErr) || generateErr.FieldName != "href" {
+		t.Errorf("An import_path without go_type should fail, got %v", err)
+	}
+}
+
+func TestInterfaceSignatures(t *testing.T) {
+	schema := `
+		type Query {
+			node: Node
+		}
+
+		interface Node {
+			id: ID!
+		}
+
+		interface Named {
+			name: String!
+		}
+
+		interface Titled {
+			name: String!
+		}
+
+		type User implements Node & Named & Titled {
+			id: ID!
+			name: String!
+		}
+	`
+
+	conf := config.Config{
+		Type: map[string]config.TypeConfig{
+			"Node":  {WithError: true},
+			"Named": {Field: map[string]config.FieldConfig{"name": {WithContext: true}}},
+		},
+	}
+	fileMap, err := NewCodeGen(schema, conf).Generate()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, expected := range []string{
+		"func (r *UserResolver) ID() (graphql.ID, error) {",
+		"func (r *UserResolver) Name(ctx context.Context) string {",
+	} {
+		if !strings.Contains(fileMap["user_gen.go"], expected) {
+			t.Errorf("Expected the signature of the interfaces %q\n%s", expected, fileMap["user_gen.go"])
+		}
+	}
+
+	conf.Type["Named"] = config.TypeConfig{Field: map[string]config.FieldConfig{"name": {GoName: "NAME"}}}
+	conf.Type["Titled"] = config.TypeConfig{Field: map[string]config.FieldConfig{"name": {GoName: "Name_"}}}
+	var generateErr *GenerateError
+	if _, err := NewCodeGen(schema, conf).Generate(); !errors.As(err, &generateErr) || generateErr.TypeName != "User" || generateErr.FieldName != "name" {
+		t.Errorf("Expected an error for the differing go_names of User.name, got %v", err)
+	}
+}
+
+func TestMethodBody(t *testing.T) {
+	schema := `
+		type Query {
+			user: User
+		}
+
+		type User {
+			name: String!
+			greeting(formal: Boolean!): String!
+			score: Float!
+		}
+	`
+
+	conf := config.Config{
+		Type: map[string]config.TypeConfig{
+			"User": {
+				WithError: true,
+				Field: map[string]config.FieldConfig{
+					"greeting": {Template: map[string]map[string]interface{}{"body": {"body": "return \"Hello \" + r.User.Name, nil"}}},
+					"score":    {Template: map[string]map[string]interface{}{"body": {}}},
+				},
+			},
+		},
+	}
+	fileMap, err := NewCodeGen(schema, conf).Generate()
+	if err != nil {
+		t.Fatal(err)
+	}
+	code := fileMap["user_gen.go"]
+	for _, expected := range []string{
+		"func (r *UserResolver) Greeting(args *UserGreetingArgs) (string, error) {\n\treturn \"Hello \" + r.User.Name, nil\n}",
+		"func (r *UserResolver) Score() (float64, error) {\n\tpanic(\"not implemented\")\n}",
+	} {
+		if !strings.Contains(code, expected) {
+			t.Errorf("Expected %s in\n%s", expected, code)
+		}
+	}
+	if strings.Contains(code, "Score float64") {
+		t.Errorf("Fields with a body should not get a struct field\n%s", code)
+	}
+}
+
+func TestMemoizeErrors(t *testing.T) {
+	schema := `
+		type Query {
+			user: User
+		}
+
+		type User {
+			name: String!
+			score: Float!
+		}
+	`
+
+	body := map[string]map[string]interface{}{"body": {"body": "return 1"}}
+	for _, test := range []struct {
+		typeName  string
+		fieldName string
+		field     config.FieldConfig
+	}{
+		{"User", "score", config.FieldConfig{Memoize: true}},
+		{"Query", "user", config.FieldConfig{Memoize: true, Template: body}},
+	} {
+		conf := config.Config{
+			Type: map[string]config.TypeConfig{
+				test.typeName: {Field: map[string]config.FieldConfig{test.fieldName: test.field}},
+			},
+		}
+		var generateErr *GenerateError
+		if _, err := NewCodeGen(schema, conf).Generate(); !errors.As(err, &generateErr) || generateErr.TypeName != test.typeName || generateErr.FieldName != test.fieldName {
+			t.Errorf("Expected an error for memoizing %s.%s, got %v", test.typeName, test.fieldName, err)
+		}
+	}
+
+	conf := config.Config{
+		Mode: "client",
+		Type: map[string]config.TypeConfig{
+			"User": {Field: map[string]config.FieldConfig{"score": {Memoize: true, Template: body}}},
+		},
+	}
+	if _, err := NewCodeGen(schema, conf).Generate(); err == nil || !strings.Contains(err.Error(), "memoize") {
+		t.Errorf("Expected an error for memoizing a client struct, got %v", err)
+	}
+}
+
+func TestTemplateDir(t *testing.T) {
+	dir, err := ioutil.TempDir("", "templates")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	templateDir := path.Join(dir, "property", "constant")
+	if err := os.MkdirAll(templateDir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	files := map[string]string{
+		"config.hcl":  "field = \"field.tmpl\"\nmethod = \"method.tmpl\"\n",
+		"field.tmpl":  "",
+		"method.tmpl": "func (r *{{resolver .TypeName}}) {{capitalize .MethodName}}() {{.MethodReturnType}} {\n\treturn {{.TemplateConfig.value}}\n}\n",
+	}
+	for name, data := range files {
+		if err := ioutil.WriteFile(path.Join(templateDir, name), []byte(data), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	schema := `
+		type Item {
+			name: String!
+			count: Int!
+		}
+	`
+	conf := config.Config{
+		TemplateDir: dir,
+		Type: map[string]config.TypeConfig{
+			"Item": config.TypeConfig{
+				Field: map[string]config.FieldConfig{
+					"count": config.FieldConfig{
+						Template: map[string]map[string]interface{}{"constant": {"value": "42"}},
+					},
+				},
+			},
+		},
+	}
+
+	fileMap, err := NewCodeGen(schema, conf).Generate()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(fileMap["item_gen.go"], "return 42") {
+		t.Errorf("Field count should use the template from the template dir\n%s", fileMap["item_gen.go"])
+	}
+	if !strings.Contains(fileMap["item_gen.go"], "return r.Item.Name") {
+		t.Errorf("Field name should fall back to the built-in template\n%s", fileMap["item_gen.go"])
+	}
+
+	conf.Type["Item"].Field["count"].Template["missing"] = nil
+	if _, err := NewCodeGen(schema, conf).Generate(); err == nil || !strings.Contains(err.Error(), `"missing" not found`) {
+		t.Errorf("A template missing from both locations should be reported, got %v", err)
+	}
+	delete(conf.Type["Item"].Field["count"].Template, "missing")
+
+	// The templates are cached by their text, an edited template is parsed again
+	g := NewCodeGen(schema, conf)
+	for _, sum := range []string{"42 + 1", "42 + 2"} {
+		method := strings.Replace(files["method.tmpl"], "{{.TemplateConfig.value}}", strings.Replace(sum, "42", "{{.TemplateConfig.value}}", 1), 1)
+		if err := ioutil.WriteFile(path.Join(templateDir, "method.tmpl"), []byte(method), 0644); err != nil {
+			t.Fatal(err)
+		}
+		fileMap, err = g.Generate()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !strings.Contains(fileMap["item_gen.go"], "return "+sum) {
+			t.Errorf("Field count should use the edited template\n%s", fileMap["item_gen.go"])
+		}
+	}
+}
+
+func TestTemplatePartials(t *testing.T) {
+	dir := t.TempDir()
+	templateDir := path.Join(dir, "type", "default")
+	if err := os.MkdirAll(templateDir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	files := map[string]string{
+		"config.hcl": "type = \"type.tmpl\"\n",
+		"type.tmpl":  "{{template \"arguments\" .}}\n",
+	}
+	for name, data := range files {
+		if err := ioutil.WriteFile(path.Join(templateDir, name), []byte(data), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	schema := `
+		type Query {
+			users(first: Int = 10): [String!]!
+		}
+	`
+	fileMap, err := NewCodeGen(schema, config.Config{TemplateDir: dir}).Generate()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, expected := range []string{"type QueryUsersArgs struct {", "const QueryUsersFirstDefault int32 = 10"} {
+		if !strings.Contains(fileMap["query_gen.go"], expected) {
+			t.Errorf("Expected the built-in arguments partial to render %s\n%s", expected, fileMap["query_gen.go"])
+		}
+	}
+}
+
+func TestTemplateFuncs(t *testing.T) {
+	dir := t.TempDir()
+	templateDir := path.Join(dir, "property", "dashed")
+	if err := os.MkdirAll(templateDir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	files := map[string]string{
+		"config.hcl":  "field = \"field.tmpl\"\nmethod = \"method.tmpl\"\n",
+		"field.tmpl":  "",
+		"method.tmpl": "func (r *{{resolver .TypeName}}) {{capitalize .MethodName}}() {{.MethodReturnType}} {\n\treturn {{printf \"%q\" (dashed .MethodName)}}\n}\n",
+	}
+	for name, data := range files {
+		if err := ioutil.WriteFile(path.Join(templateDir, name), []byte(data), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	schema := `
+		type Item {
+			displayName: String!
+		}
+	`
+	conf := config.Config{
+		TemplateDir: dir,
+		TemplateFuncs: template.FuncMap{
+			"dashed": func(name string) string { return strings.ToLower(snakeCase(name)) },
+		},
+		Type: map[string]config.TypeConfig{
+			"Item": {
+				Field: map[string]config.FieldConfig{
+					"displayName": {Template: map[string]map[string]interface{}{"dashed": {}}},
+				},
+			},
+		},
+	}
+
+	fileMap, err := NewCodeGen(schema, conf).Generate()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(fileMap["item_gen.go"], `return "display_name"`) {
+		t.Errorf("Expected the custom func to be called by the template\n%s", fileMap["item_gen.go"])
+	}
+
+	for _, funcs := range []template.FuncMap{
+		{"capitalize": strings.ToUpper},
+		{"not a name": strings.ToUpper},
+		{"value": "not a func"},
+	} {
+		conf.TemplateFuncs = funcs
+		if _, err := NewCodeGen(schema, conf).Generate(); err == nil {
+			t.Errorf("Expected an error for the template funcs %v", funcs)
+		}
+	}
+}
+
+func TestTemplateFuncsPerCodeGen(t *testing.T) {
+	dir := t.TempDir()
+	templateDir := path.Join(dir, "property", "renamed")
+	if err := os.MkdirAll(templateDir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	files := map[string]string{
+		"config.hcl":  "field = \"field.tmpl\"\nmethod = \"method.tmpl\"\n",
+		"field.tmpl":  "",
+		"method.tmpl": "func (r *{{resolver .TypeName}}) {{capitalize .MethodName}}() {{.MethodReturnType}} {\n\treturn {{printf \"%q\" (rename .MethodName)}}\n}\n",
+	}
+	for name, data := range files {
+		if err := ioutil.WriteFile(path.Join(templateDir, name), []byte(data), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	schema := `
+		type Item {
+			displayName: String!
+		}
+	`
+	newConf := func(funcs template.FuncMap) config.Config {
+		return config.Config{
+			TemplateDir:   dir,
+			TemplateFuncs: funcs,
+			Type: map[string]config.TypeConfig{
+				"Item": {
+					Field: map[string]config.FieldConfig{
+						"displayName": {Template: map[string]map[string]interface{}{"renamed": {}}},
+					},
+				},
+			},
+		}
+	}
+
+	// The second CodeGen parses nothing, it gets the template cached by the first
+	for _, rename := range []func(string) string{strings.ToUpper, strings.ToLower} {
+		fileMap, err := NewCodeGen(schema, newConf(template.FuncMap{"rename": rename})).Generate()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if expected := fmt.Sprintf("return %q", rename("displayName")); !strings.Contains(fileMap["item_gen.go"], expected) {
+			t.Errorf("Expected the template funcs of the CodeGen, %s\n%s", expected, fileMap["item_gen.go"])
+		}
+	}
+
+	if _, err := NewCodeGen(schema, newConf(nil)).Generate(); err == nil || !strings.Contains(err.Error(), "rename") {
+		t.Errorf("Expected an error for the template func of another CodeGen, got %v", err)
+	}
+}
+
+func BenchmarkGenerate(b *testing.B) {
+	schema := &strings.Builder{}
+	schema.WriteString("schema {\n\tquery: Query\n}\n\ntype Query {\n\titem: Item\n}\n\ntype Item {\n")
+	for i := 0; i < 200; i++ {
+		fmt.Fprintf(schema, "\tfield%d(arg: Int): String!\n", i)
+	}
+	schema.WriteString("}\n")
+
+	g := NewCodeGen(schema.String(), config.Config{})
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := g.Generate(); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkGenerateTypes(b *testing.B) {
+	schema := &strings.Builder{}
+	schema.WriteString("type Query {\n\titem0: Item0\n}\n")
+	for i := 0; i < 100; i++ {
+		fmt.Fprintf(schema, "\ntype Item%d {\n\tid: ID!\n\tname: String\n\tcount: Int!\n\tnext: Item%d\n}\n", i, (i+1)%100)
+	}
+
+	g := NewCodeGen(schema.String(), config.Config{})
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := g.Generate(); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,159 @@
+package codegen
+
+import (
+	"bytes"
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/Applifier/graphql-codegen/config"
+	codegenTemplate "github.com/Applifier/graphql-codegen/template"
+	"github.com/neelance/graphql-go/introspection"
+)
+
+// dataloaderImport is the import of github.com/graph-gophers/dataloader, the
+// loaders are written for its interface{} API of up to v5
+const dataloaderImport = "\"github.com/graph-gophers/dataloader\""
+
+// loaderData is a dataloader generated for a list field of an object type
+type loaderData struct {
+	// Name is the Go name of the loader type, e.g. UserPostsLoader
+	Name string
+	// Batch is the name of the batch function seeded for the loader
+	Batch string
+	// Source is the schema field the loader loads, e.g. User.posts
+	Source string
+	// Type is the Go type of the loaded list
+	Type string
+	// KeyType is the Go type of the ID keying the loads
+	KeyType string
+}
+
+// loadsFields reports whether a field of the named type is configured with
+// dataloader, so the type gets a file seeded with the batch functions
+func (g *CodeGen) loadsFields(name string, conf config.Config) bool {
+	if g.generatesStructs() {
+		return false
+	}
+	for _, fieldConf := range conf.Type[name].Field {
+		if fieldConf.Dataloader {
+			return true
+		}
+	}
+	return false
+}
+
+// dataloaderFields returns the loaders of the fields configured with
+// dataloader. The fields have to be lists of objects, interfaces or unions on
+// an object type with an id field of type ID, which keys the loads.
+func (g *CodeGen) dataloaderFields(fields []*introspection.Field, tp *introspection.Type, typeConf config.TypeConfig, conf config.Config) ([]loaderData, error) {
+	name := *tp.Name()
+	loaders := []loaderData{}
+	for _, fp := range fields {
+		propConf := typeConf.Field[fp.Name()]
+		if !propConf.Dataloader {
+			continue
+		}
+		if tp.Kind() != "OBJECT" || g.isEntryPoint(name) || g.generatesStructs() {
+			return nil, &GenerateError{TypeName: name, FieldName: fp.Name(), Err: fmt.Errorf("dataloader is only supported on the resolvers of object types, batching the loads of many objects")}
+		}
+
+		elem := fp.Type()
+		if elem.Kind() == "NON_NULL" {
+			elem = elem.OfType()
+		}
+		if elem.Kind() != "LIST" {
+			return nil, &GenerateError{TypeName: name, FieldName: fp.Name(), Err: fmt.Errorf("dataloader needs a list field, not %s", elem.Kind())}
+		}
+		elem = elem.OfType()
+		if elem.Kind() == "NON_NULL" {
+			elem = elem.OfType()
+		}
+		switch elem.Kind() {
+		case "OBJECT", "INTERFACE", "UNION":
+		default:
+			return nil, &GenerateError{TypeName: name, FieldName: fp.Name(), Err: fmt.Errorf("dataloader needs a list of objects, not of %s", elem.Kind())}
+		}
+
+		keyType, err := g.loaderKeyType(fields, tp, conf)
+		if err != nil {
+			return nil, &GenerateError{TypeName: name, FieldName: fp.Name(), Err: err}
+		}
+
+		fieldType := g.getTypeName(fp.Type(), conf, false)
+		if propConf.GoType != "" {
+			fieldType = propConf.GoType
+		}
+		loaderName := g.goName(name) + g.fieldGoName(fp.Name(), propConf)
+		loaders = append(loaders, loaderData{
+			Name:    loaderName + "Loader",
+			Batch:   "batch" + loaderName,
+			Source:  name + "." + fp.Name(),
+			Type:    fieldType,
+			KeyType: keyType,
+		})
+	}
+	return loaders, nil
+}
+
+// loaderKeyType returns the Go type of the id field of type ID the loads of
+// the type are keyed by
+func (g *CodeGen) loaderKeyType(fields []*introspection.Field, tp *introspection.Type, conf config.Config) (string, error) {
+	for _, fp := range fields {
+		if fp.Name() != "id" {
+			continue
+		}
+		idType := fp.Type()
+		if idType.Kind() == "NON_NULL" && idType.OfType().Kind() == "SCALAR" && *idType.OfType().Name() == "ID" {
+			return g.getTypeName(idType, conf, false), nil
+		}
+	}
+	return "", fmt.Errorf("dataloader keys the loads by the id of %s, the type has no non-null id field of type ID", *tp.Name())
+}
+
+// generateLoaders generates the file seeded with the batch functions of the
+// loaders of a type, which are implemented by hand. Like seeded resolvers, the
+// file has no generated code marker.
+func (g *CodeGen) generateLoaders(tp *introspection.Type, conf config.Config) (string, error) {
+	name := *tp.Name()
+	conf.Package, _ = g.typePackage(name, conf)
+
+	typeTemplate, err := codegenTemplate.GetTypeTemplateFromDir(conf.TemplateDir, "loaders")
+	if err != nil {
+		return "", &GenerateError{TypeName: name, Template: "loaders", Err: err}
+	}
+
+	tmpl, err := g.parseTemplate(path.Join(typeTemplate.Dir, "type", "loaders"), strings.Trim(typeTemplate.TypeTemplate, " \t"))
+	if err != nil {
+		return "", &GenerateError{TypeName: name, Template: "loaders", Err: err}
+	}
+
+	typeConf := conf.Type[name]
+	var ifields []*introspection.Field
+	if tp.Fields(&struct{ IncludeDeprecated bool }{!typeConf.SkipDeprecated}) != nil {
+		ifields = *tp.Fields(&struct{ IncludeDeprecated bool }{!typeConf.SkipDeprecated})
+	}
+	loaders, err := g.dataloaderFields(ifields, tp, typeConf, conf)
+	if err != nil {
+		return "", err
+	}
+
+	buf := &bytes.Buffer{}
+	err = tmpl.Execute(buf, map[string]interface{}{
+		"Kind":     tp.Kind(),
+		"TypeName": g.goName(name),
+		"Loaders":  loaders,
+		"Imports":  append([]string{"\"context\"", "\"errors\"", dataloaderImport}, typeTemplate.Config.Imports...),
+		"Config":   conf,
+	})
+	if err != nil {
+		return "", &GenerateError{TypeName: name, Template: "loaders", Err: err}
+	}
+
+	header := fmt.Sprintf("// Batch functions of the dataloaders of %s, created by graphql-codegen and not overwritten afterwards\n\npackage %s\n\n", name, conf.Package)
+	b, err := formatGenerated(header + string(buf.Bytes()))
+	if err != nil {
+		return "", &GenerateError{TypeName: name, Err: err}
+	}
+	return string(b), nil
+}
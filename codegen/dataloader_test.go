@@ -0,0 +1,102 @@
+package codegen
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/Applifier/graphql-codegen/config"
+)
+
+const dataloaderSchema = `
+	type Query {
+		user(id: ID!): User
+	}
+
+	type User {
+		id: ID!
+		name: String!
+		posts: [Post!]!
+		drafts: [Post]
+		tags: [String!]!
+		favorite: Post
+	}
+
+	type Post {
+		title: String!
+	}
+`
+
+func dataloaderConfig(fields ...string) config.Config {
+	fieldConfs := map[string]config.FieldConfig{}
+	for _, field := range fields {
+		fieldConfs[field] = config.FieldConfig{Dataloader: true}
+	}
+	return config.Config{Type: map[string]config.TypeConfig{"User": {Field: fieldConfs}}}
+}
+
+func TestDataloader(t *testing.T) {
+	fileMap, err := NewCodeGen(dataloaderSchema, dataloaderConfig("posts", "drafts")).Generate()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	user := fileMap["user_gen.go"]
+	for _, expected := range []string{
+		"\"github.com/graph-gophers/dataloader\"",
+		"type UserPostsLoader struct {\n\tloader *dataloader.Loader\n}",
+		"func NewUserPostsLoader(opts ...dataloader.Option) *UserPostsLoader {\n\treturn &UserPostsLoader{loader: dataloader.NewBatchedLoader(batchUserPosts, opts...)}\n}",
+		"func (l *UserPostsLoader) Load(ctx context.Context, id graphql.ID) ([]*PostResolver, error) {",
+		"func (l *UserDraftsLoader) Load(ctx context.Context, id graphql.ID) (*[]*PostResolver, error) {",
+	} {
+		if !strings.Contains(user, expected) {
+			t.Errorf("Expected %s in\n%s", expected, user)
+		}
+	}
+
+	loaders, ok := fileMap["user_loaders.go"]
+	if !ok {
+		t.Fatalf("Expected the batch functions to be seeded into user_loaders.go, got %v", sortedFileNames(fileMap))
+	}
+	for _, expected := range []string{
+		"func batchUserPosts(ctx context.Context, keys dataloader.Keys) []*dataloader.Result {",
+		"func batchUserDrafts(ctx context.Context, keys dataloader.Keys) []*dataloader.Result {",
+	} {
+		if !strings.Contains(loaders, expected) {
+			t.Errorf("Expected %s in\n%s", expected, loaders)
+		}
+	}
+	if isGenerated([]byte(loaders)) {
+		t.Errorf("The seeded batch functions should have no generated code marker\n%s", loaders)
+	}
+
+	entries, err := GeneratePlan(dataloaderSchema, dataloaderConfig("posts"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var planned bool
+	for _, entry := range entries {
+		planned = planned || (entry.Loaders && entry.TypeName == "User" && entry.FileName == "user_loaders.go")
+	}
+	if !planned {
+		t.Errorf("Expected user_loaders.go in the plan, got %+v", entries)
+	}
+}
+
+func TestDataloaderErrors(t *testing.T) {
+	for _, field := range []string{"name", "tags", "favorite"} {
+		var generateErr *GenerateError
+		if _, err := NewCodeGen(dataloaderSchema, dataloaderConfig(field)).Generate(); !errors.As(err, &generateErr) || generateErr.FieldName != field {
+			t.Errorf("Expected an error for the dataloader of User.%s, got %v", field, err)
+		}
+	}
+
+	withoutID := strings.Replace(dataloaderSchema, "id: ID!\n", "", 1)
+	if _, err := NewCodeGen(withoutID, dataloaderConfig("posts")).Generate(); err == nil || !strings.Contains(err.Error(), "no non-null id field") {
+		t.Errorf("Expected an error for loading by a missing id, got %v", err)
+	}
+
+	if _, err := GenerateSingleFile(dataloaderSchema, dataloaderConfig("posts")); err == nil {
+		t.Error("Expected an error for seeding batch functions into a single file")
+	}
+}
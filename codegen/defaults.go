@@ -0,0 +1,179 @@
+package codegen
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/Applifier/graphql-codegen/config"
+	"github.com/neelance/graphql-go/introspection"
+)
+
+// argumentDefault is the Go declaration of the default value of an argument
+type argumentDefault struct {
+	// Name is the name of the constant or variable, e.g. QueryUsersFirstDefault
+	Name string
+	// ArgumentName is the schema name of the argument
+	ArgumentName string
+	// Type is the Go type of the constant, empty for a variable
+	Type string
+	// Value is the Go literal of the default value
+	Value string
+}
+
+// argumentDefaults returns the declarations of the default values of the
+// arguments of fp, held in the arguments struct argsName. Scalars and enums
+// are declared as constants and lists of them as variables. Defaults without a
+// Go literal, like input objects, custom scalars, built-in scalars mapped to
+// another Go type or lists of nullable values, are left out.
+func (g *CodeGen) argumentDefaults(argsName string, fp *introspection.Field, conf config.Config) []argumentDefault {
+	var defaults []argumentDefault
+	for _, arg := range fp.Args() {
+		if arg.DefaultValue() == nil {
+			continue
+		}
+		goType := strings.TrimPrefix(g.getTypeName(arg.Type(), conf, true), "*")
+		value, list, ok := g.goLiteral(*arg.DefaultValue(), arg.Type(), conf)
+		if !ok {
+			continue
+		}
+		def := argumentDefault{
+			Name:         strings.TrimSuffix(argsName, "Args") + g.fieldName(arg.Name()) + "Default",
+			ArgumentName: arg.Name(),
+			Type:         goType,
+			Value:        value,
+		}
+		if list {
+			def.Type = ""
+			def.Value = goType + "{" + value + "}"
+		}
+		defaults = append(defaults, def)
+	}
+	return defaults
+}
+
+// goLiteral converts a GraphQL literal of the type tp, as reported by
+// introspection, to a Go literal. Lists are returned as their comma separated
+// elements, with list set.
+func (g *CodeGen) goLiteral(literal string, tp *introspection.Type, conf config.Config) (value string, list bool, ok bool) {
+	if tp.Kind() == "NON_NULL" {
+		tp = tp.OfType()
+	}
+	literal = strings.TrimSpace(literal)
+	if literal == "null" {
+		return "", false, false
+	}
+
+	switch tp.Kind() {
+	case "LIST":
+		elem := tp.OfType()
+		if elem.Kind() != "NON_NULL" || elem.OfType().Kind() == "LIST" {
+			return "", false, false
+		}
+		items, ok := listItems(literal)
+		if !ok {
+			// A single value is coerced to a list of one
+			items = []string{literal}
+		}
+		values := make([]string, len(items))
+		for i, item := range items {
+			if values[i], _, ok = g.goLiteral(item, elem, conf); !ok {
+				return "", false, false
+			}
+		}
+		return strings.Join(values, ", "), true, true
+	case "ENUM":
+		if !isName(literal) {
+			return "", false, false
+		}
+		name := *tp.Name()
+		return g.qualifiedName(name, g.goName(name)+g.capitalise(literal), conf), false, true
+	case "SCALAR":
+		// The literal of the schema type may not be one of the Go type
+		if _, mapped := conf.Scalar[*tp.Name()]; mapped {
+			return "", false, false
+		}
+		switch *tp.Name() {
+		case "Int":
+			if _, err := strconv.ParseInt(literal, 10, 32); err != nil {
+				return "", false, false
+			}
+			return literal, false, true
+		case "Float":
+			if _, err := strconv.ParseFloat(literal, 64); err != nil {
+				return "", false, false
+			}
+			return literal, false, true
+		case "Boolean":
+			if literal != "true" && literal != "false" {
+				return "", false, false
+			}
+			return literal, false, true
+		case "String", "ID":
+			if s, ok := stringValue(literal); ok {
+				return strconv.Quote(s), false, true
+			}
+			// IDs are also given as integers
+			if _, err := strconv.ParseInt(literal, 10, 64); err == nil && *tp.Name() == "ID" {
+				return strconv.Quote(literal), false, true
+			}
+		}
+	}
+	return "", false, false
+}
+
+// listItems splits a GraphQL list literal into its items, which are separated
+// by commas or white space. Nested lists and objects are not supported.
+func listItems(literal string) ([]string, bool) {
+	if !strings.HasPrefix(literal, "[") || !strings.HasSuffix(literal, "]") {
+		return nil, false
+	}
+	src := literal[1 : len(literal)-1]
+	items := []string{}
+	for i := 0; i < len(src); i++ {
+		switch c := src[i]; {
+		case c == ',' || c == ' ' || c == '\t' || c == '\n' || c == '\r':
+		case c == '[' || c == '{':
+			return nil, false
+		case c == '"':
+			end := skipString(src, i)
+			if end >= len(src) {
+				return nil, false
+			}
+			items = append(items, src[i:end+1])
+			i = end
+		default:
+			start := i
+			for i+1 < len(src) && !strings.ContainsRune(", \t\n\r", rune(src[i+1])) {
+				i++
+			}
+			items = append(items, src[start:i+1])
+		}
+	}
+	return items, true
+}
+
+// stringValue returns the value of a GraphQL string literal. Its escapes are
+// the ones of Go but for \/, block strings are not supported.
+func stringValue(literal string) (string, bool) {
+	if len(literal) < 2 || literal[0] != '"' || literal[len(literal)-1] != '"' || strings.HasPrefix(literal, `"""`) {
+		return "", false
+	}
+	s, err := strconv.Unquote(strings.Replace(literal, `\/`, "/", -1))
+	if err != nil {
+		return "", false
+	}
+	return s, true
+}
+
+// isName reports whether s is a GraphQL name, like an enum value
+func isName(s string) bool {
+	if s == "" || !isNameStart(s[0]) {
+		return false
+	}
+	for i := 1; i < len(s); i++ {
+		if !isNameChar(s[i]) {
+			return false
+		}
+	}
+	return true
+}
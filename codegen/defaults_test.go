@@ -0,0 +1,95 @@
+package codegen
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/Applifier/graphql-codegen/config"
+)
+
+func TestArgumentDefaultConstants(t *testing.T) {
+	schema := `
+		type Query {
+			users(first: Int = 10, name: String = "a \"b\"", order: Order = NAME, tags: [String!] = ["x", "y"], orders: [Order!]! = [AGE], after: ID, filter: Filter = {active: true}, since: Time = "2020-01-01", ids: [ID] = [1]): [User!]!
+		}
+
+		enum Order {
+			NAME
+			AGE
+		}
+
+		input Filter {
+			active: Boolean
+		}
+
+		scalar Time
+
+		type User {
+			name: String!
+		}
+	`
+
+	fileMap, err := NewCodeGen(schema, config.Config{}).Generate()
+	if err != nil {
+		t.Fatal(err)
+	}
+	query := fileMap["query_gen.go"]
+	for _, expected := range []string{
+		"// QueryUsersFirstDefault is the default of the first argument of Query.users\nconst QueryUsersFirstDefault int32 = 10\n",
+		"const QueryUsersNameDefault string = \"a \\\"b\\\"\"\n",
+		"const QueryUsersOrderDefault Order = OrderNAME\n",
+		"var QueryUsersTagsDefault = []string{\"x\", \"y\"}\n",
+		"var QueryUsersOrdersDefault = []Order{OrderAGE}\n",
+	} {
+		if !strings.Contains(query, expected) {
+			t.Errorf("Expected %q in\n%s", expected, query)
+		}
+	}
+	for _, unexpected := range []string{"QueryUsersAfterDefault", "QueryUsersFilterDefault", "QueryUsersSinceDefault", "QueryUsersIdsDefault"} {
+		if strings.Contains(query, unexpected) {
+			t.Errorf("Expected no %s, the default has no Go literal\n%s", unexpected, query)
+		}
+	}
+
+	// The literals of the schema types do not fit mapped built-in scalars
+	conf := config.Config{Scalar: map[string]config.ScalarConfig{
+		"ID":  {GoType: "int64"},
+		"Int": {GoType: "uint"},
+	}}
+	schema = `
+		type Query {
+			user(id: ID = "1", first: Int = 10, name: String = "a"): String!
+		}
+	`
+	fileMap, err = NewCodeGen(schema, conf).Generate()
+	if err != nil {
+		t.Fatal(err)
+	}
+	query = fileMap["query_gen.go"]
+	if !strings.Contains(query, "const QueryUserNameDefault string = \"a\"\n") {
+		t.Errorf("Expected the default of the string argument\n%s", query)
+	}
+	for _, unexpected := range []string{"QueryUserIDDefault", "QueryUserFirstDefault"} {
+		if strings.Contains(query, unexpected) {
+			t.Errorf("Expected no %s, the scalar is mapped to another Go type\n%s", unexpected, query)
+		}
+	}
+}
+
+func TestListItems(t *testing.T) {
+	for literal, expected := range map[string][]string{
+		`[]`:               {},
+		`[1, 2 3]`:         {"1", "2", "3"},
+		`["a, b", "c\"]"]`: {`"a, b"`, `"c\"]"`},
+		`[NAME,AGE]`:       {"NAME", "AGE"},
+		`[[1], [2]]`:       nil,
+		`[{a: 1}]`:         nil,
+		`1`:                nil,
+		`["unterminated]`:  nil,
+	} {
+		items, ok := listItems(literal)
+		if ok != (expected != nil) || strings.Join(items, "|") != strings.Join(expected, "|") {
+			t.Errorf("Expected %q for %s, got %q (%t)", expected, literal, items, ok)
+		}
+	}
+}
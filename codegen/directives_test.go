@@ -0,0 +1,47 @@
+package codegen
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/Applifier/graphql-codegen/config"
+)
+
+func TestGoTypeDirectiveGenerate(t *testing.T) {
+	schema := `
+		scalar UUID @goType(name: "uuid.UUID", import: "github.com/google/uuid")
+		scalar Time @goType(name: "time.Time", import: "time")
+
+		type Query {
+			user: User
+		}
+
+		type User {
+			id: UUID!
+			createdAt: Time!
+		}
+	`
+
+	conf := config.Config{
+		Scalar:         map[string]config.ScalarConfig{"Time": {GoType: "int64"}},
+		GenerateSchema: true,
+	}
+	fileMap, err := NewCodeGen(schema, conf).Generate()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := fileMap["uuid_gen.go"]; ok {
+		t.Error("Mapped scalars should not get a placeholder")
+	}
+	for _, expected := range []string{"ID uuid.UUID", "CreatedAt time.Time", `"github.com/google/uuid"`} {
+		if !strings.Contains(fileMap["user_gen.go"], expected) {
+			t.Errorf("Expected %s in\n%s", expected, fileMap["user_gen.go"])
+		}
+	}
+	if strings.Contains(fileMap["schema_gen.go"], "@goType") || !strings.Contains(fileMap["schema_gen.go"], "scalar UUID\n") {
+		t.Errorf("The schema constant should leave out the directives\n%s", fileMap["schema_gen.go"])
+	}
+	if len(conf.Scalar) != 1 {
+		t.Error("The config should not be modified")
+	}
+}
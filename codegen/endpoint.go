@@ -0,0 +1,134 @@
+package codegen
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/Applifier/graphql-codegen/config"
+)
+
+// endpointTimeout limits the introspection request of GenerateFromEndpoint
+const endpointTimeout = 30 * time.Second
+
+// introspectionQuery is the introspection query of the fields read by
+// introspectionSchema. Type references are followed seven levels deep, enough
+// for a list of lists of non-null types.
+const introspectionQuery = `query IntrospectionQuery {
+  __schema {
+    queryType { name }
+    mutationType { name }
+    subscriptionType { name }
+    types {
+      kind
+      name
+      description
+      fields(includeDeprecated: true) {
+        name
+        description
+        args { ...InputValue }
+        type { ...TypeRef }
+        isDeprecated
+        deprecationReason
+      }
+      inputFields { ...InputValue }
+      interfaces { ...TypeRef }
+      enumValues(includeDeprecated: true) {
+        name
+        description
+        isDeprecated
+        deprecationReason
+      }
+      possibleTypes { ...TypeRef }
+    }
+  }
+}
+
+fragment InputValue on __InputValue {
+  name
+  description
+  type { ...TypeRef }
+  defaultValue
+}
+
+fragment TypeRef on __Type {
+  kind
+  name
+  ofType { kind name ofType { kind name ofType { kind name ofType { kind name ofType { kind name ofType { kind name ofType { kind name } } } } } } }
+}`
+
+// GenerateFromEndpoint generates code from the schema of a running GraphQL
+// server, sending the introspection query to url with headers, e.g. for
+// authorization. The request times out after 30 seconds, use
+// GenerateFromEndpointContext for another timeout.
+func GenerateFromEndpoint(url string, headers map[string]string, conf config.Config) (map[string]string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), endpointTimeout)
+	defer cancel()
+	return GenerateFromEndpointContext(ctx, url, headers, conf)
+}
+
+// GenerateFromEndpointContext is GenerateFromEndpoint with the introspection
+// request bound to ctx
+func GenerateFromEndpointContext(ctx context.Context, url string, headers map[string]string, conf config.Config) (map[string]string, error) {
+	introspectionJSON, err := fetchIntrospection(ctx, url, headers)
+	if err != nil {
+		return nil, err
+	}
+	return GenerateFromIntrospection(introspectionJSON, conf)
+}
+
+// fetchIntrospection posts the introspection query to url and returns the
+// JSON response. Errors reported by the server are returned as an error.
+func fetchIntrospection(ctx context.Context, url string, headers map[string]string) ([]byte, error) {
+	body, err := json.Marshal(map[string]string{"query": introspectionQuery})
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json")
+	for name, value := range headers {
+		req.Header.Set(name, value)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("introspecting %s: %v", url, err)
+	}
+	defer resp.Body.Close()
+
+	data, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("introspecting %s: %v", url, err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("introspecting %s: %s", url, resp.Status)
+	}
+
+	var result struct {
+		Errors []struct {
+			Message string `json:"message"`
+		} `json:"errors"`
+	}
+	if err := json.Unmarshal(data, &result); err != nil {
+		return nil, fmt.Errorf("introspecting %s: invalid response: %v", url, err)
+	}
+	if len(result.Errors) > 0 {
+		messages := make([]string, len(result.Errors))
+		for i, e := range result.Errors {
+			messages[i] = e.Message
+		}
+		return nil, fmt.Errorf("introspecting %s: %s", url, strings.Join(messages, "; "))
+	}
+	return data, nil
+}
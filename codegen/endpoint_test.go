@@ -0,0 +1,82 @@
+package codegen
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Applifier/graphql-codegen/config"
+)
+
+func TestGenerateFromEndpoint(t *testing.T) {
+	schema := `
+		type Query {
+			user(id: ID!): User
+		}
+
+		type User {
+			id: ID!
+			name: String
+		}
+	`
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/" {
+			http.NotFound(w, r)
+			return
+		}
+		var req struct {
+			Query string `json:"query"`
+		}
+		if r.Method != http.MethodPost || json.NewDecoder(r.Body).Decode(&req) != nil || !strings.Contains(req.Query, "__schema") {
+			http.Error(w, "expected an introspection query", http.StatusBadRequest)
+			return
+		}
+		if r.Header.Get("Authorization") != "Bearer token" {
+			w.Write([]byte(`{"errors": [{"message": "not authorized"}]}`))
+			return
+		}
+		w.Write(introspectionJSON(t, schema))
+	}))
+	defer server.Close()
+
+	conf := config.Config{Package: "users"}
+	expected, err := NewCodeGen(schema, conf).Generate()
+	if err != nil {
+		t.Fatal(err)
+	}
+	result, err := GenerateFromEndpoint(server.URL, map[string]string{"Authorization": "Bearer token"}, conf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(result, expected) {
+		t.Error("generating from the endpoint should match generating from the schema")
+	}
+
+	if _, err := GenerateFromEndpoint(server.URL, nil, conf); err == nil || !strings.Contains(err.Error(), "not authorized") {
+		t.Errorf("Expected the error of the server, got %v", err)
+	}
+	if _, err := GenerateFromEndpoint(server.URL+"/missing", nil, conf); err == nil {
+		t.Error("Expected an error for the status of the response")
+	}
+}
+
+func TestGenerateFromEndpointTimeout(t *testing.T) {
+	done := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-done
+	}))
+	defer server.Close()
+	defer close(done)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if _, err := GenerateFromEndpointContext(ctx, server.URL, nil, config.Config{}); err == nil {
+		t.Error("Expected the request to time out")
+	}
+}
@@ -0,0 +1,28 @@
+package codegen
+
+import "fmt"
+
+// GenerateError identifies the schema type, field and template that failed
+// to generate
+type GenerateError struct {
+	TypeName  string
+	FieldName string
+	Template  string
+	Err       error
+}
+
+func (e *GenerateError) Error() string {
+	location := e.TypeName
+	if e.FieldName != "" {
+		location += "." + e.FieldName
+	}
+	if e.Template != "" {
+		return fmt.Sprintf("generating %s with template %q: %v", location, e.Template, e.Err)
+	}
+	return fmt.Sprintf("generating %s: %v", location, e.Err)
+}
+
+// Unwrap returns the underlying error
+func (e *GenerateError) Unwrap() error {
+	return e.Err
+}
@@ -0,0 +1,63 @@
+package codegen
+
+import (
+	"fmt"
+	"strings"
+	"text/template"
+	"unicode"
+)
+
+// fileNameTemplate returns the template naming the generated files for a
+// file naming scheme, lower or snake, or the scheme itself as a template
+func (g *CodeGen) fileNameTemplate(naming string) (*template.Template, error) {
+	switch naming {
+	case "", "lower":
+		naming = "{{lower .Name}}{{.Suffix}}"
+	case "snake":
+		naming = "{{snake .Name}}{{.Suffix}}"
+	}
+
+	tmpl, err := template.New("file_naming").Funcs(template.FuncMap{
+		"lower": strings.ToLower,
+		"snake": snakeCase,
+	}).Parse(naming)
+	if err != nil {
+		return nil, fmt.Errorf("invalid file_naming: %v", err)
+	}
+	return tmpl, nil
+}
+
+// fileName returns the name of a file generated for the Go type name, named
+// by the namer. The suffix, _gen.go or _mock_gen.go, tells the files of the
+// same type apart.
+func (g *CodeGen) fileName(name string, kind string, suffix string) (string, error) {
+	fileName, err := g.namer().FileName(name, kind, suffix)
+	if err != nil {
+		return "", err
+	}
+
+	// Go ignores files starting with _
+	fileName = strings.TrimLeft(strings.TrimSpace(fileName), "_")
+	if !strings.HasSuffix(fileName, ".go") || strings.HasSuffix(fileName, "_test.go") || strings.ContainsAny(fileName, `/\`) {
+		return "", fmt.Errorf("file_naming for %s: invalid file name %q, expected a .go file name without a directory", name, fileName)
+	}
+	return fileName, nil
+}
+
+// snakeCase converts a camel case name to snake case, keeping acronyms
+// together so HTTPClient becomes http_client
+func snakeCase(name string) string {
+	runes := []rune(name)
+	buf := &strings.Builder{}
+	for i, r := range runes {
+		if unicode.IsUpper(r) && i > 0 && runes[i-1] != '_' {
+			prev := runes[i-1]
+			nextIsLower := i+1 < len(runes) && unicode.IsLower(runes[i+1])
+			if unicode.IsLower(prev) || unicode.IsDigit(prev) || (unicode.IsUpper(prev) && nextIsLower) {
+				buf.WriteRune('_')
+			}
+		}
+		buf.WriteRune(unicode.ToLower(r))
+	}
+	return buf.String()
+}
@@ -0,0 +1,120 @@
+package codegen
+
+import (
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/Applifier/graphql-codegen/config"
+)
+
+func TestSnakeCase(t *testing.T) {
+	tests := map[string]string{
+		"":                 "",
+		"user":             "user",
+		"User":             "user",
+		"SomeLongTypeName": "some_long_type_name",
+		"HTTPClient":       "http_client",
+		"UserID":           "user_id",
+		"ID":               "id",
+		"HTTP2Server":      "http2_server",
+		"APIError":         "api_error",
+		"already_snake":    "already_snake",
+		"Review_Input":     "review_input",
+	}
+
+	for in, expected := range tests {
+		if result := snakeCase(in); result != expected {
+			t.Errorf("snakeCase(%q) = %q, expected %q", in, result, expected)
+		}
+	}
+}
+
+func TestFileNaming(t *testing.T) {
+	schema := `
+		type Query {
+			client: HTTPClient
+		}
+
+		type HTTPClient {
+			baseURL: String!
+		}
+	`
+
+	tests := []struct {
+		naming   string
+		expected []string
+	}{
+		{"", []string{"httpclient_gen.go", "query_gen.go", "resolver_gen.go"}},
+		{"lower", []string{"httpclient_gen.go", "query_gen.go", "resolver_gen.go"}},
+		{"snake", []string{"http_client_gen.go", "query_gen.go", "resolver_gen.go"}},
+		{"{{snake .Name}}.generated.go", []string{"http_client.generated.go", "query.generated.go", "resolver.generated.go"}},
+		{"_{{lower .Name}}{{.Suffix}}", []string{"httpclient_gen.go", "query_gen.go", "resolver_gen.go"}},
+	}
+
+	for _, test := range tests {
+		fileMap, err := NewCodeGen(schema, config.Config{FileNaming: test.naming}).Generate()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		fileNames := make([]string, 0, len(fileMap))
+		for fileName := range fileMap {
+			fileNames = append(fileNames, fileName)
+		}
+		sort.Strings(fileNames)
+		if len(fileNames) != len(test.expected) {
+			t.Errorf("File naming %q generated %q, expected %q", test.naming, fileNames, test.expected)
+			continue
+		}
+		for i, fileName := range fileNames {
+			if fileName != test.expected[i] {
+				t.Errorf("File naming %q generated %q, expected %q", test.naming, fileNames, test.expected)
+				break
+			}
+		}
+	}
+
+	for _, naming := range []string{"{{snake .Name", "{{.Name}}", "{{.Name}}_test.go", "gen/{{.Name}}.go"} {
+		if _, err := NewCodeGen(schema, config.Config{FileNaming: naming}).Generate(); err == nil {
+			t.Errorf("File naming %q should be rejected", naming)
+		}
+	}
+}
+
+func TestFileNameCollisions(t *testing.T) {
+	schema := `
+		type Query {
+			fooBar: FooBar
+			foobar: Foobar
+		}
+
+		type FooBar {
+			id: ID!
+		}
+
+		type Foobar {
+			id: ID!
+		}
+
+		type Resolver {
+			id: ID!
+		}
+	`
+
+	_, err := NewCodeGen(schema, config.Config{}).Generate()
+	if err == nil {
+		t.Fatal("Types generated into the same file should be rejected")
+	}
+	for _, expected := range []string{"FooBar, Foobar are all generated into foobar_gen.go", "Resolver, entry point Resolver are all generated into resolver_gen.go"} {
+		if !strings.Contains(err.Error(), expected) {
+			t.Errorf("Error %q should contain %q", err, expected)
+		}
+	}
+
+	// Snake case names tell the types apart, except for the Resolver
+	conf := config.Config{FileNaming: "snake", Ignore: []string{"Resolver"}}
+	if _, err := NewCodeGen(schema, conf).Generate(); err != nil {
+		t.Error(err)
+	}
+}
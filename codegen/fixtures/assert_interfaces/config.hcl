@@ -0,0 +1,13 @@
+package = "assert_interfaces"
+assert_interfaces = true
+
+type "Node" {
+  with_context = true
+}
+
+type "Named" {
+  field "display_name" {
+    go_name = "DisplayName"
+    with_error = true
+  }
+}
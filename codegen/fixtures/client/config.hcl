@@ -0,0 +1,2 @@
+package = "client"
+mode = "client"
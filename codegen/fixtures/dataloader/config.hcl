@@ -0,0 +1,11 @@
+package = "loaders"
+
+scalar "ID" {
+  go_type = "int64"
+}
+
+type "User" {
+  field "posts" {
+    dataloader = true
+  }
+}
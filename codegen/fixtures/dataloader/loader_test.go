@@ -0,0 +1,17 @@
+package loaders
+
+import (
+	"context"
+	"testing"
+)
+
+func TestLoaderKey(t *testing.T) {
+	// The IDs are int64, which the loader keys by their decimal string
+	posts, err := NewUserPostsLoader().Load(context.Background(), 42)
+	if err == nil || err.Error() != "batchUserPosts is not implemented" {
+		t.Errorf("Expected the error of the seeded batch function, got %v", err)
+	}
+	if posts != nil {
+		t.Errorf("Expected no posts, got %v", posts)
+	}
+}
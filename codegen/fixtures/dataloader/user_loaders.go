@@ -0,0 +1,20 @@
+// Batch functions of the dataloaders of User, created by graphql-codegen and not overwritten afterwards
+
+package loaders
+
+import (
+	"context"
+	"errors"
+
+	"github.com/graph-gophers/dataloader"
+)
+
+// batchUserPosts loads User.posts of the Users with the IDs of keys, for
+// UserPostsLoader. The result at each index holds the []*PostResolver of the key at the index.
+func batchUserPosts(ctx context.Context, keys dataloader.Keys) []*dataloader.Result {
+	results := make([]*dataloader.Result, len(keys))
+	for i := range keys {
+		results[i] = &dataloader.Result{Error: errors.New("batchUserPosts is not implemented")}
+	}
+	return results
+}
@@ -0,0 +1,5 @@
+package = "deprecated"
+
+type "Account" {
+  skip_deprecated = true
+}
@@ -0,0 +1,18 @@
+package = "embed_struct"
+embed_struct = "deps.Deps"
+embed_import_path = "github.com/Applifier/graphql-codegen/codegen/fixtures/embed_struct/deps"
+generate_registry = true
+
+type "User" {
+  field "greeting" {
+    template "body" {
+      body = "return r.Deps.Greeting + \" \" + r.User.Name"
+    }
+  }
+}
+
+type "Query" {
+  field "user" {
+    template "custom" {}
+  }
+}
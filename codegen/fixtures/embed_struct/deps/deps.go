@@ -0,0 +1,9 @@
+// Package deps holds the dependencies shared by the resolvers of the
+// embed_struct fixture
+package deps
+
+// Deps is embedded into every resolver
+type Deps struct {
+	Greeting string
+	Users    map[string]string
+}
@@ -0,0 +1,22 @@
+package embed_struct
+
+import (
+	"testing"
+
+	"github.com/Applifier/graphql-codegen/codegen/fixtures/embed_struct/deps"
+)
+
+func TestEmbeddedDeps(t *testing.T) {
+	r := NewResolver(&deps.Deps{Greeting: "Hello", Users: map[string]string{"1": "Anna"}})
+
+	user := r.User(&QueryUserArgs{ID: "1"})
+	if user == nil {
+		t.Fatal("Expected user 1")
+	}
+	if greeting := user.Greeting(); greeting != "Hello Anna" {
+		t.Errorf("Expected the greeting of the embedded Deps, got %q", greeting)
+	}
+	if r.User(&QueryUserArgs{ID: "2"}) != nil {
+		t.Error("Expected no user 2")
+	}
+}
@@ -0,0 +1,12 @@
+package embed_struct
+
+// This file contains the resolver of Query.user, reading the users from the embedded Deps
+
+// User returns the user with the ID, sharing the dependencies of the Resolver
+func (r *Resolver) User(args *QueryUserArgs) *UserResolver {
+	name, ok := r.Users[string(args.ID)]
+	if !ok {
+		return nil
+	}
+	return &UserResolver{User: User{ID: args.ID, Name: name}, Deps: r.Deps}
+}
@@ -0,0 +1,14 @@
+package = "enum"
+
+type "Provider" {
+  include_deprecated = true
+  sql = true
+}
+
+type "OrderState" {
+  labels = {
+    PENDING = "Pending payment"
+    PAID = "Paid"
+    SHIPPED = "Shipped"
+  }
+}
@@ -0,0 +1,39 @@
+package enum
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestEnumJSON(t *testing.T) {
+	data, err := json.Marshal(struct{ State OrderState }{OrderStatePAID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != `{"State":"PAID"}` {
+		t.Errorf("Marshalled %s, expected the schema name of the value", data)
+	}
+
+	var state OrderState
+	if err := json.Unmarshal([]byte(`"SHIPPED"`), &state); err != nil || state != OrderStateSHIPPED {
+		t.Errorf("Unmarshalled %q, %v, expected SHIPPED", state, err)
+	}
+
+	err = json.Unmarshal([]byte(`"LOST"`), &state)
+	if err == nil || !strings.Contains(err.Error(), `invalid OrderState value "LOST"`) {
+		t.Errorf("Unknown value should name the value and the enum, got %v", err)
+	}
+
+	if _, err := json.Marshal(OrderState("LOST")); err == nil {
+		t.Error("Marshalling an unknown value should fail")
+	}
+
+	// Deprecated values are valid in the schema even without their constants
+	if !OrderState("SENT").IsValid() || !ProviderCASH.IsValid() {
+		t.Error("Deprecated values should be valid with and without include_deprecated")
+	}
+	if err := json.Unmarshal([]byte(`"SENT"`), &state); err != nil || state != "SENT" {
+		t.Errorf("Unmarshalled %q, %v, expected the deprecated SENT", state, err)
+	}
+}
@@ -0,0 +1,19 @@
+package enum
+
+import (
+	"testing"
+)
+
+func TestEnumLabel(t *testing.T) {
+	if label := OrderStatePENDING.Label(); label != "Pending payment" {
+		t.Errorf("Label of PENDING is %q, expected the configured label", label)
+	}
+
+	state, err := ParseOrderStateLabel("Shipped")
+	if err != nil || state != OrderStateSHIPPED {
+		t.Errorf("Parsed %q, %v, expected SHIPPED", state, err)
+	}
+	if _, err := ParseOrderStateLabel("Lost"); err == nil {
+		t.Error("Parsing an unknown label should fail")
+	}
+}
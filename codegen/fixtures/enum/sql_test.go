@@ -0,0 +1,33 @@
+package enum
+
+import (
+	"testing"
+)
+
+func TestEnumScan(t *testing.T) {
+	var provider Provider
+	if err := provider.Scan([]byte("INVOICE")); err != nil || provider != ProviderINVOICE {
+		t.Errorf("Scanned %q, %v, expected INVOICE", provider, err)
+	}
+	if err := provider.Scan("CASH"); err != nil || provider != ProviderCASH {
+		t.Errorf("Scanned %q, %v, expected the deprecated CASH", provider, err)
+	}
+
+	for _, src := range []interface{}{"CHEQUE", nil, 1} {
+		if err := provider.Scan(src); err == nil {
+			t.Errorf("Scanning %v should fail", src)
+		}
+	}
+	if provider != ProviderCASH {
+		t.Errorf("A failed scan changed the value to %q", provider)
+	}
+}
+
+func TestEnumValue(t *testing.T) {
+	if value, err := ProviderCARD.Value(); err != nil || value != "CARD" {
+		t.Errorf("Value of CARD is %v, %v", value, err)
+	}
+	if _, err := Provider("CHEQUE").Value(); err == nil {
+		t.Error("The value of an unknown provider should fail")
+	}
+}
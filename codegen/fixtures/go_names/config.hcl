@@ -0,0 +1,13 @@
+package = "go_names"
+
+type "Error" {
+  go_name = "APIError"
+}
+
+type "Kind" {
+  go_name = "ErrorKind"
+}
+
+type "Context" {
+  go_name = "RequestContext"
+}
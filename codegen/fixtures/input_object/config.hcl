@@ -0,0 +1 @@
+package = "input_object"
@@ -0,0 +1,19 @@
+package = "memoize"
+
+type "User" {
+  field "followers" {
+    memoize = true
+    template "body" {
+      body = "return r.countFollowers()"
+    }
+  }
+
+  field "posts" {
+    memoize = true
+    with_context = true
+    with_error = true
+    template "body" {
+      body = "return r.fetchPosts(ctx, args.First, args.After)"
+    }
+  }
+}
@@ -0,0 +1,67 @@
+package memoize
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+func TestMemoizedMethods(t *testing.T) {
+	atomic.StoreInt32(&calls, 0)
+	r := &UserResolver{User: User{ID: "1", Name: "Anna"}}
+
+	if r.Followers() != 42 || r.Followers() != 42 {
+		t.Error("Expected 42 followers")
+	}
+	if n := atomic.LoadInt32(&calls); n != 1 {
+		t.Errorf("Expected the followers to be counted once, counted %d times", n)
+	}
+
+	ctx := context.Background()
+	after := "1-0"
+	for _, args := range []*UserPostsArgs{{First: 2}, {First: 2}, {First: 3}, {First: 2, After: &after}, {First: 2, After: &after}} {
+		posts, err := r.Posts(ctx, args)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(posts) != int(args.First) {
+			t.Errorf("Expected %d posts, got %v", args.First, posts)
+		}
+	}
+	if n := atomic.LoadInt32(&calls); n != 4 {
+		t.Errorf("Expected the posts to be fetched once for each distinct arguments, calls %d", n)
+	}
+}
+
+func TestMemoizedErrors(t *testing.T) {
+	atomic.StoreInt32(&calls, 0)
+	r := &UserResolver{User: User{ID: "1"}}
+
+	missing := "missing"
+	for i := 0; i < 2; i++ {
+		if _, err := r.Posts(context.Background(), &UserPostsArgs{First: 1, After: &missing}); err == nil {
+			t.Error("Expected an error")
+		}
+	}
+	if n := atomic.LoadInt32(&calls); n != 2 {
+		t.Errorf("Expected errors not to be cached, calls %d", n)
+	}
+}
+
+func TestMemoizedConcurrently(t *testing.T) {
+	r := &UserResolver{User: User{ID: "1"}}
+
+	wg := sync.WaitGroup{}
+	for i := 0; i < 16; i++ {
+		wg.Add(1)
+		go func(first int32) {
+			defer wg.Done()
+			r.Followers()
+			if posts, err := r.Posts(context.Background(), &UserPostsArgs{First: first}); err != nil || len(posts) != int(first) {
+				t.Errorf("Expected %d posts, got %v, %v", first, posts, err)
+			}
+		}(int32(i % 4))
+	}
+	wg.Wait()
+}
@@ -0,0 +1,35 @@
+package memoize
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync/atomic"
+)
+
+// This file contains the resolvers the memoized methods of UserResolver call
+
+// calls counts the calls of the resolvers below
+var calls int32
+
+// countFollowers stands in for an expensive count
+func (r *UserResolver) countFollowers() int32 {
+	atomic.AddInt32(&calls, 1)
+	return 42
+}
+
+// fetchPosts stands in for a request to another service
+func (r *UserResolver) fetchPosts(ctx context.Context, first int32, after *string) ([]string, error) {
+	atomic.AddInt32(&calls, 1)
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if after != nil && *after == "missing" {
+		return nil, errors.New("no post missing")
+	}
+	posts := make([]string, first)
+	for i := range posts {
+		posts[i] = fmt.Sprintf("%s-%d", r.User.ID, i)
+	}
+	return posts, nil
+}
@@ -0,0 +1,106 @@
+// Code generated by graphql-codegen v1.0.0. DO NOT EDIT.
+
+package memoize
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+
+	graphql "github.com/neelance/graphql-go"
+)
+
+type User struct {
+	// ID
+	ID graphql.ID `json:"id"`
+	// Name
+	Name string `json:"name"`
+}
+
+// UserResolver resolver for User
+type UserResolver struct {
+	User
+
+	memoMu        sync.Mutex
+	memoFollowers map[string]int32
+	memoPosts     map[string][]string
+}
+
+// ID
+func (r *UserResolver) ID() graphql.ID {
+	return r.User.ID
+}
+
+// Name
+func (r *UserResolver) Name() string {
+	return r.User.Name
+}
+
+// Followers The number of followers, counted on every call
+func (r *UserResolver) Followers() int32 {
+	resolve := func() int32 {
+		return r.countFollowers()
+	}
+	key := ""
+
+	r.memoMu.Lock()
+	cached, ok := r.memoFollowers[key]
+	r.memoMu.Unlock()
+	if ok {
+		return cached
+	}
+
+	v := resolve()
+	r.memoMu.Lock()
+	if r.memoFollowers == nil {
+		r.memoFollowers = map[string]int32{}
+	}
+	r.memoFollowers[key] = v
+	r.memoMu.Unlock()
+	return v
+}
+
+// Posts The posts of the user, fetched page by page
+func (r *UserResolver) Posts(ctx context.Context, args *UserPostsArgs) ([]string, error) {
+	resolve := func() ([]string, error) {
+		return r.fetchPosts(ctx, args.First, args.After)
+	}
+	b, err := json.Marshal(args)
+	if err != nil {
+		return resolve()
+	}
+	key := string(b)
+
+	r.memoMu.Lock()
+	cached, ok := r.memoPosts[key]
+	r.memoMu.Unlock()
+	if ok {
+		return cached, nil
+	}
+
+	v, err := resolve()
+	if err != nil {
+		return v, err
+	}
+	r.memoMu.Lock()
+	if r.memoPosts == nil {
+		r.memoPosts = map[string][]string{}
+	}
+	r.memoPosts[key] = v
+	r.memoMu.Unlock()
+	return v, nil
+}
+
+// UserPostsArgs arguments for User.posts
+type UserPostsArgs struct {
+	First int32
+	After *string
+}
+
+func (r *UserResolver) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&r.User)
+}
+
+func (r *UserResolver) UnmarshalJSON(data []byte) error {
+	return json.Unmarshal(data, &r.User)
+}
@@ -0,0 +1,2 @@
+package = "meta_types"
+include_meta_types = true
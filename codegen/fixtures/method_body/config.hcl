@@ -0,0 +1,13 @@
+package = "method_body"
+
+type "User" {
+  field "fullName" {
+    template "body" {
+      body = "return r.User.FirstName + \" \" + r.User.LastName"
+    }
+  }
+
+  field "unread" {
+    template "body" {}
+  }
+}
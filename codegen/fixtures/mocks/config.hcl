@@ -0,0 +1,16 @@
+package = "mocks"
+mocks = true
+
+type "Node" {
+  field "related" {
+    with_context = true
+    with_error = true
+  }
+}
+
+type "User" {
+  field "related" {
+    with_context = true
+    with_error = true
+  }
+}
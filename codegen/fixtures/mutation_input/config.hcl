@@ -0,0 +1,5 @@
+package = "mutation_input"
+
+type "Mutation" {
+  unwrap_input = true
+}
@@ -0,0 +1,26 @@
+package = "null_strategy"
+
+type "Review" {
+  field "id" {
+    null_strategy = "sqlNull"
+  }
+  field "stars" {
+    null_strategy = "zero"
+  }
+  field "rating" {
+    null_strategy = "sqlNull"
+    with_error = true
+  }
+  field "commentary" {
+    null_strategy = "sqlNull"
+  }
+  field "approved" {
+    null_strategy = "sqlNull"
+  }
+  field "tags" {
+    null_strategy = "zero"
+  }
+  field "author" {
+    null_strategy = "pointer"
+  }
+}
@@ -0,0 +1,20 @@
+package = "packages"
+import_path = "github.com/Applifier/graphql-codegen/codegen/fixtures/packages"
+
+type "Episode" {
+  package = "inputs"
+}
+
+type "ReviewInput" {
+  package = "inputs"
+}
+
+type "Review" {
+  package = "models"
+  dir = "internal/models"
+}
+
+type "Author" {
+  package = "models"
+  dir = "internal/models"
+}
@@ -0,0 +1,2 @@
+package = "registry"
+generate_registry = true
@@ -0,0 +1,2 @@
+package = "resolver_suffix"
+resolver_suffix = "ResolverImpl"
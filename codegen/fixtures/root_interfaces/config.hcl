@@ -0,0 +1,6 @@
+package = "root_interfaces"
+root_interfaces = true
+
+type "Subscription" {
+  with_context = true
+}
@@ -0,0 +1,37 @@
+package root_interfaces
+
+import (
+	"testing"
+
+	graphql "github.com/neelance/graphql-go"
+)
+
+// queries implements the query type on its own, as another package could
+type queries struct {
+	messages []*MessageResolver
+}
+
+func (q *queries) Message(args *QueryMessageArgs) *MessageResolver {
+	for _, message := range q.messages {
+		if message.ID() == args.ID {
+			return message
+		}
+	}
+	return nil
+}
+
+func (q *queries) Messages() []*MessageResolver {
+	return q.messages
+}
+
+func TestRootResolver(t *testing.T) {
+	q := &queries{messages: []*MessageResolver{{Message{ID: "1", Text: "hello"}}}}
+	root := &RootResolver{QueryResolver: q}
+
+	if message := root.Message(&QueryMessageArgs{ID: graphql.ID("1")}); message == nil || message.Text() != "hello" {
+		t.Errorf("Expected the query resolver to resolve the message, got %v", message)
+	}
+	if len(root.Messages()) != 1 {
+		t.Errorf("Expected the messages of the query resolver, got %v", root.Messages())
+	}
+}
@@ -0,0 +1,6 @@
+package = "scalar_assertions"
+
+scalar "Timestamp" {
+  go_type = "Timestamp"
+  assert = true
+}
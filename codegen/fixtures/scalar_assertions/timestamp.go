@@ -0,0 +1,41 @@
+package scalar_assertions
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// This file contains the Timestamp the scalar of the same name is mapped to
+
+// Timestamp is a time read from the seconds since the Unix epoch
+type Timestamp struct {
+	time.Time
+}
+
+// ImplementsGraphQLType reports whether Timestamp decodes the named scalar
+func (t Timestamp) ImplementsGraphQLType(name string) bool {
+	return name == "Timestamp"
+}
+
+// UnmarshalGraphQL decodes the seconds of a Timestamp input
+func (t *Timestamp) UnmarshalGraphQL(input interface{}) error {
+	switch input := input.(type) {
+	case int32:
+		t.Time = time.Unix(int64(input), 0)
+	case string:
+		seconds, err := strconv.ParseInt(input, 10, 64)
+		if err != nil {
+			return err
+		}
+		t.Time = time.Unix(seconds, 0)
+	default:
+		return fmt.Errorf("invalid Timestamp %v", input)
+	}
+	return nil
+}
+
+// MarshalJSON encodes the seconds of a Timestamp
+func (t Timestamp) MarshalJSON() ([]byte, error) {
+	return strconv.AppendInt(nil, t.Unix(), 10), nil
+}
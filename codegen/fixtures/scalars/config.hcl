@@ -0,0 +1,6 @@
+package = "scalars"
+
+scalar "DateTime" {
+  go_type = "time.Time"
+  import_path = "time"
+}
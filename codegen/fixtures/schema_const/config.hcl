@@ -0,0 +1,2 @@
+package = "schema_const"
+generate_schema = true
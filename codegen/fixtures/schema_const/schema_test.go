@@ -0,0 +1,17 @@
+package schema_const
+
+import (
+	"io/ioutil"
+	"testing"
+)
+
+func TestSchema(t *testing.T) {
+	schema, err := ioutil.ReadFile("schema.graphql")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if Schema != string(schema) {
+		t.Errorf("Schema should hold the schema\n%s", Schema)
+	}
+}
@@ -0,0 +1,6 @@
+package = "seed_resolvers"
+seed_resolvers = true
+
+type "Query" {
+  with_context = true
+}
@@ -0,0 +1,8 @@
+// Resolvers of Mutation, created by graphql-codegen and not overwritten afterwards
+
+package seed_resolvers
+
+// Publish
+func (r *Resolver) Publish(args *MutationPublishArgs) *PostResolver {
+	panic("not implemented")
+}
@@ -0,0 +1,17 @@
+// Resolvers of Query, created by graphql-codegen and not overwritten afterwards
+
+package seed_resolvers
+
+import (
+	"context"
+)
+
+// Posts The posts, newest first
+func (r *Resolver) Posts(ctx context.Context, args *QueryPostsArgs) []*PostResolver {
+	panic("not implemented")
+}
+
+// Post
+func (r *Resolver) Post(ctx context.Context, args *QueryPostArgs) *PostResolver {
+	panic("not implemented")
+}
@@ -0,0 +1,16 @@
+package = "stringer"
+
+type "User" {
+  string_field = "name"
+}
+
+type "Tag" {
+  string_field = "name"
+}
+
+type "Product" {
+  string_field = "code"
+  field "code" {
+    null_strategy = "sqlNull"
+  }
+}
@@ -0,0 +1,8 @@
+package = "subscription"
+
+type "Subscription" {
+  with_context = true
+  field "onlineCount" {
+    with_error = true
+  }
+}
@@ -0,0 +1,2 @@
+package = "type_prefix"
+type_prefix = "Admin"
@@ -0,0 +1 @@
+package = "union_description"
@@ -0,0 +1,9 @@
+package = "validate"
+
+type "SearchFilter" {
+  validate = true
+}
+
+type "Page" {
+  validate = true
+}
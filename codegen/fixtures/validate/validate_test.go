@@ -0,0 +1,37 @@
+package validate
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestValidate(t *testing.T) {
+	red, lost := ColorRED, Color("LOST")
+
+	valid := &SearchFilter{
+		Color:     ColorGREEN,
+		Preferred: &red,
+		Colors:    &[]Color{ColorRED},
+		Palettes:  [][]*Color{{&red, nil}},
+	}
+	if err := valid.Validate(); err != nil {
+		t.Errorf("Known values should be valid, got %v", err)
+	}
+
+	tests := map[string]*SearchFilter{
+		"color":     {Color: lost},
+		"preferred": {Color: ColorRED, Preferred: &lost},
+		"colors":    {Color: ColorRED, Colors: &[]Color{ColorRED, lost}},
+		"palettes":  {Color: ColorRED, Palettes: [][]*Color{{&red}, {&lost}}},
+	}
+	for field, filter := range tests {
+		err := filter.Validate()
+		if err == nil || !strings.Contains(err.Error(), `invalid Color value "LOST" for `+field) {
+			t.Errorf("Unknown value in %s should be reported, got %v", field, err)
+		}
+	}
+
+	if err := (&Page{}).Validate(); err != nil {
+		t.Errorf("Input without enum fields should be valid, got %v", err)
+	}
+}
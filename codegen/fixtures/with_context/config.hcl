@@ -0,0 +1,11 @@
+package = "with_context"
+
+type "Query" {
+  with_context = true
+}
+
+type "Account" {
+  field "balance" {
+    with_context = true
+  }
+}
@@ -0,0 +1,15 @@
+package = "with_error"
+
+type "Query" {
+  with_error = true
+}
+
+type "Document" {
+  with_error = true
+}
+
+type "Invoice" {
+  field "total" {
+    with_error = true
+  }
+}
@@ -2,12 +2,36 @@ package codegen
 
 import (
 	"bytes"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
 	"os"
 	"os/exec"
+	"path"
+	"sort"
+	"strconv"
 	"strings"
+	"unicode"
 )
 
 func FormatCode(code string) ([]byte, error) {
+	return formatCode(code, false)
+}
+
+// formatGenerated formats generated code like FormatCode, leaving out the
+// imports the code does not use. Types append the imports of each part they
+// are generated from, so a file would otherwise import the union of them.
+func formatGenerated(code string) ([]byte, error) {
+	return formatCode(code, true)
+}
+
+func formatCode(code string, prune bool) ([]byte, error) {
+	code, err := fixImports(code, prune)
+	if err != nil {
+		return []byte(code), err
+	}
+
 	fmtCmd := exec.Command("gofmt", "-s")
 	fmtCmd.Stdin = strings.NewReader(code)
 	var out bytes.Buffer
@@ -23,3 +47,175 @@ func FormatCode(code string) ([]byte, error) {
 
 	return out.Bytes(), nil
 }
+
+// fixImports replaces the import declarations of code with a single block
+// with standard library imports grouped before third-party ones, each group
+// sorted by path. With prune the imports whose name is not used in code are
+// left out, blank and dot imports are always kept. So are the imports without
+// a name that cannot be known from the path, like github.com/satori/go.uuid.
+func fixImports(code string, prune bool) (string, error) {
+	if strings.TrimSpace(code) == "" {
+		return code, nil
+	}
+
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, "", code, 0)
+	if err != nil {
+		return code, fmt.Errorf("generated code does not parse: %v", err)
+	}
+
+	var decls []*ast.GenDecl
+	for _, decl := range f.Decls {
+		if genDecl, ok := decl.(*ast.GenDecl); ok && genDecl.Tok == token.IMPORT {
+			decls = append(decls, genDecl)
+		}
+	}
+	if len(decls) == 0 {
+		return code, nil
+	}
+
+	// Package names are not declared in the file, so the parser leaves the
+	// identifiers referring to imports unresolved
+	used := map[string]bool{}
+	for _, ident := range f.Unresolved {
+		used[ident.Name] = true
+	}
+
+	// Imports are told apart by the name they are used with, so "time" and
+	// time "time" are the same import while two packages imported with the
+	// same name would not compile
+	var std, thirdParty []string
+	seen := map[string]bool{}
+	namedPaths := map[string]string{}
+	for _, decl := range decls {
+		for _, spec := range decl.Specs {
+			importSpec := spec.(*ast.ImportSpec)
+			line := importLine(importSpec)
+			specPath := strings.Trim(importSpec.Path.Value, "\"")
+			name := assumedPackageName(specPath)
+			known := knownPackageName(specPath)
+			if importSpec.Name != nil {
+				name = importSpec.Name.Name
+				known = true
+			}
+			if seen[name+" "+specPath] || (prune && known && !used[name] && name != "_" && name != ".") {
+				continue
+			}
+			seen[name+" "+specPath] = true
+			if known {
+				if other, ok := namedPaths[name]; ok && name != "_" && name != "." {
+					return code, fmt.Errorf("imports %q and %q are both named %s", other, specPath, name)
+				}
+				namedPaths[name] = specPath
+			}
+
+			if isStdLib(specPath) {
+				std = append(std, line)
+			} else {
+				thirdParty = append(thirdParty, line)
+			}
+		}
+	}
+	sortByPath(std)
+	sortByPath(thirdParty)
+
+	block := &bytes.Buffer{}
+	if len(std) > 0 || len(thirdParty) > 0 {
+		block.WriteString("import (\n")
+		for _, line := range std {
+			fmt.Fprintf(block, "\t%s\n", line)
+		}
+		if len(std) > 0 && len(thirdParty) > 0 {
+			block.WriteString("\n")
+		}
+		for _, line := range thirdParty {
+			fmt.Fprintf(block, "\t%s\n", line)
+		}
+		block.WriteString(")")
+	}
+
+	result := &bytes.Buffer{}
+	last := 0
+	for i, decl := range decls {
+		start := fset.Position(decl.Pos()).Offset
+		end := fset.Position(decl.End()).Offset
+		result.WriteString(code[last:start])
+		if i == 0 {
+			result.Write(block.Bytes())
+		}
+		last = end
+	}
+	result.WriteString(code[last:])
+
+	return result.String(), nil
+}
+
+// importLine returns the import of spec as written in an import block, the
+// quoted path preceded by the package name if it has one
+func importLine(spec *ast.ImportSpec) string {
+	if spec.Name != nil {
+		return spec.Name.Name + " " + spec.Path.Value
+	}
+	return spec.Path.Value
+}
+
+// assumedPackageName returns the name a package is used with when it is
+// imported without a name, the last element of the path without a major
+// version, a go- prefix or anything after the first character not allowed in
+// an identifier, like goimports assumes. So github.com/neelance/graphql-go is
+// graphql.
+func assumedPackageName(importPath string) string {
+	base := path.Base(importPath)
+	if strings.HasPrefix(base, "v") {
+		if _, err := strconv.Atoi(base[1:]); err == nil && path.Dir(importPath) != "." {
+			base = path.Base(path.Dir(importPath))
+		}
+	}
+	base = strings.TrimPrefix(base, "go-")
+	if i := strings.IndexFunc(base, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '_'
+	}); i >= 0 {
+		base = base[:i]
+	}
+	return base
+}
+
+// knownPackageName reports whether the name of a package imported without a
+// name is known from its path: the last element of a standard library path
+// or one that is an identifier as it is. The names of paths like
+// gopkg.in/yaml.v2 or github.com/satori/go.uuid are only assumed.
+func knownPackageName(importPath string) bool {
+	return isStdLib(importPath) || assumedPackageName(importPath) == path.Base(importPath)
+}
+
+// importFor returns the import of importPath for the Go type goType, named
+// after the package qualifying goType when the import would be assumed to have
+// another name, e.g. uuid "github.com/satori/go.uuid" for uuid.UUID
+func importFor(goType string, importPath string) string {
+	name := strings.TrimLeft(goType, "*[]")
+	if dot := strings.Index(name, "."); dot > 0 && token.IsIdentifier(name[:dot]) && name[:dot] != assumedPackageName(importPath) {
+		return fmt.Sprintf("%s %q", name[:dot], importPath)
+	}
+	return fmt.Sprintf("%q", importPath)
+}
+
+// isStdLib reports whether importPath looks like a standard library package,
+// i.e. its first path element has no dot in it
+func isStdLib(importPath string) bool {
+	return !strings.Contains(strings.SplitN(importPath, "/", 2)[0], ".")
+}
+
+func sortByPath(lines []string) {
+	sort.SliceStable(lines, func(i, j int) bool {
+		return importPath(lines[i]) < importPath(lines[j])
+	})
+}
+
+// importPath returns the quoted path of an import line, which may start with
+// a package name
+func importPath(line string) string {
+	if i := strings.Index(line, "\""); i >= 0 {
+		return line[i:]
+	}
+	return line
+}
@@ -0,0 +1,194 @@
+package codegen
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestFormatCodeImports(t *testing.T) {
+	tests := []struct {
+		code     string
+		expected string
+	}{
+		{
+			code: `package main
+
+import (
+	graphql "github.com/neelance/graphql-go"
+
+	"net/http"
+
+	"encoding/json"
+	"net/http"
+)
+
+import "fmt"
+
+var _ = fmt.Sprint
+`,
+			expected: `package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	graphql "github.com/neelance/graphql-go"
+)
+
+var _ = fmt.Sprint
+`,
+		},
+		{
+			code: `package main
+
+import (
+)
+
+type A struct{}
+`,
+			expected: `package main
+
+type A struct{}
+`,
+		},
+	}
+
+	for _, test := range tests {
+		code, err := FormatCode(test.code)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if string(code) != test.expected {
+			t.Errorf("Formatted code\n%s\n\nshould have matched\n\n%s", code, test.expected)
+		}
+	}
+}
+
+func TestFormatCodeImportNames(t *testing.T) {
+	code, err := FormatCode(`package main
+
+import (
+	"time"
+	graphql "github.com/neelance/graphql-go"
+)
+
+import (
+	time "time"
+	"github.com/neelance/graphql-go"
+	clock "time"
+)
+
+var _ = time.Now
+var _ = clock.Now
+var _ graphql.ID
+`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := `import (
+	"time"
+	clock "time"
+
+	graphql "github.com/neelance/graphql-go"
+)`
+	if !strings.Contains(string(code), expected) {
+		t.Errorf("Expected the imports used with the same name to be merged\n%s", code)
+	}
+
+	for _, imports := range []string{
+		`import (
+	"github.com/example/api/types"
+	"github.com/example/billing/types"
+)`,
+		`import (
+	uuid "github.com/google/uuid"
+	uuid "github.com/gofrs/uuid"
+)`,
+		`import (
+	"math/rand"
+	rand "crypto/rand"
+)`,
+	} {
+		if _, err := FormatCode("package main\n\n" + imports + "\n"); err == nil || !strings.Contains(err.Error(), "are both named") {
+			t.Errorf("Expected an error for\n%s\ngot %v", imports, err)
+		}
+	}
+}
+
+func TestFormatGeneratedUnknownImportNames(t *testing.T) {
+	// The package of go.uuid is named uuid, not go as assumed from its path,
+	// so the import is neither pruned nor taken to clash with an import named go
+	code, err := formatGenerated(`package main
+
+import (
+	"github.com/satori/go.uuid"
+	goexample "github.com/example/go"
+	"gopkg.in/yaml.v2"
+	"github.com/example/unused"
+)
+
+var _ uuid.UUID
+var _ = goexample.Name
+`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, expected := range []string{"\t\"github.com/satori/go.uuid\"\n", "\t\"gopkg.in/yaml.v2\"\n"} {
+		if !strings.Contains(string(code), expected) {
+			t.Errorf("Expected the import %s to be kept\n%s", strings.TrimSpace(expected), code)
+		}
+	}
+	if strings.Contains(string(code), "github.com/example/unused") {
+		t.Errorf("Expected the unused import with a known name to be pruned\n%s", code)
+	}
+
+	if _, err := FormatCode("package main\n\nimport (\n\t\"github.com/example/go-billing\"\n\tbilling \"github.com/example/billing\"\n)\n"); err != nil {
+		t.Errorf("Expected no clash with an import whose name is only assumed, got %v", err)
+	}
+}
+
+func TestImportFor(t *testing.T) {
+	for _, test := range []struct {
+		goType, importPath, expected string
+	}{
+		{"uuid.UUID", "github.com/satori/go.uuid", `uuid "github.com/satori/go.uuid"`},
+		{"*[]uuid.UUID", "github.com/satori/go.uuid", `uuid "github.com/satori/go.uuid"`},
+		{"time.Time", "time", `"time"`},
+		{"graphql.ID", "github.com/neelance/graphql-go", `"github.com/neelance/graphql-go"`},
+		{"Money", "github.com/example/billing", `"github.com/example/billing"`},
+	} {
+		if result := importFor(test.goType, test.importPath); result != test.expected {
+			t.Errorf("importFor(%q, %q) = %s, expected %s", test.goType, test.importPath, result, test.expected)
+		}
+	}
+}
+
+func TestAssumedPackageName(t *testing.T) {
+	tests := []struct {
+		importPath string
+		expected   string
+	}{
+		{"time", "time"},
+		{"encoding/json", "json"},
+		{"github.com/neelance/graphql-go", "graphql"},
+		{"github.com/go-yaml/yaml", "yaml"},
+		{"github.com/example/go-billing", "billing"},
+		{"gopkg.in/yaml.v2", "yaml"},
+		{"github.com/example/api/v2", "api"},
+	}
+
+	for _, test := range tests {
+		if result := assumedPackageName(test.importPath); result != test.expected {
+			t.Errorf("assumedPackageName(%q) = %q, expected %q", test.importPath, result, test.expected)
+		}
+	}
+}
+
+func TestFormatCodeParseError(t *testing.T) {
+	_, err := FormatCode("package main\n\nfunc {")
+	if err == nil || !strings.Contains(err.Error(), "does not parse") {
+		t.Errorf("Expected a parse error for invalid code, got %v", err)
+	}
+}
@@ -0,0 +1,193 @@
+package codegen
+
+import (
+	"strings"
+)
+
+// irregularPlurals maps singular English nouns to plurals the suffix rules
+// get wrong
+var irregularPlurals = map[string]string{
+	"person": "people",
+	"child":  "children",
+	"man":    "men",
+	"woman":  "women",
+	"mouse":  "mice",
+	"goose":  "geese",
+	"foot":   "feet",
+	"tooth":  "teeth",
+	"ox":     "oxen",
+	"datum":  "data",
+	"index":  "indices",
+	"matrix": "matrices",
+	"vertex": "vertices",
+	"leaf":   "leaves",
+	"life":   "lives",
+	"knife":  "knives",
+	"wife":   "wives",
+	"half":   "halves",
+}
+
+// uncountables are nouns whose plural is the same as the singular
+var uncountables = map[string]bool{
+	"equipment":   true,
+	"information": true,
+	"money":       true,
+	"news":        true,
+	"series":      true,
+	"sheep":       true,
+	"species":     true,
+	"fish":        true,
+	"deer":        true,
+	"metadata":    true,
+}
+
+var irregularSingulars = func() map[string]string {
+	singulars := make(map[string]string, len(irregularPlurals))
+	for singular, plural := range irregularPlurals {
+		singulars[plural] = singular
+	}
+	return singulars
+}()
+
+// pluralize returns the plural of the last word of a camel case name, so
+// "node" becomes "nodes" and "friendPerson" becomes "friendPeople"
+func (g *CodeGen) pluralize(name string) string {
+	return inflectLastWord(name, func(word string) string {
+		if uncountables[word] {
+			return word
+		}
+		if plural, ok := irregularPlurals[word]; ok {
+			return plural
+		}
+		switch {
+		case hasAnySuffix(word, "s", "x", "z", "ch", "sh"):
+			return word + "es"
+		case strings.HasSuffix(word, "y") && len(word) > 1 && !isVowel(word[len(word)-2]):
+			return word[:len(word)-1] + "ies"
+		}
+		return word + "s"
+	})
+}
+
+// singularize returns the singular of the last word of a camel case name, so
+// "nodes" becomes "node" and "friendPeople" becomes "friendPerson"
+func (g *CodeGen) singularize(name string) string {
+	return inflectLastWord(name, func(word string) string {
+		if uncountables[word] {
+			return word
+		}
+		if singular, ok := irregularSingulars[word]; ok {
+			return singular
+		}
+		switch {
+		case strings.HasSuffix(word, "ies") && len(word) > 3:
+			return word[:len(word)-3] + "y"
+		case hasAnySuffix(word, "sses", "xes", "zes", "ches", "shes"):
+			return word[:len(word)-2]
+		case strings.HasSuffix(word, "ss"), strings.HasSuffix(word, "us"):
+			return word
+		case strings.HasSuffix(word, "s") && len(word) > 1:
+			return word[:len(word)-1]
+		}
+		return word
+	})
+}
+
+// inflectLastWord applies inflect to the lower cased last word of a camel
+// case name, keeping the case of the first letter of the word
+func inflectLastWord(name string, inflect func(string) string) string {
+	start := 0
+	for i := len(name) - 1; i > 0; i-- {
+		if name[i] >= 'A' && name[i] <= 'Z' && name[i-1] >= 'a' && name[i-1] <= 'z' {
+			start = i
+			break
+		}
+	}
+
+	word := name[start:]
+	if word == "" {
+		return name
+	}
+	upper := word[0] >= 'A' && word[0] <= 'Z'
+	inflected := inflect(strings.ToLower(word))
+	if upper {
+		inflected = strings.ToUpper(inflected[:1]) + inflected[1:]
+	}
+	return name[:start] + inflected
+}
+
+// initialisms are the words written in upper case in Go names, like
+// golint expects
+var initialisms = map[string]bool{
+	"ACL":   true,
+	"API":   true,
+	"ASCII": true,
+	"CPU":   true,
+	"CSS":   true,
+	"DNS":   true,
+	"EOF":   true,
+	"GUID":  true,
+	"HTML":  true,
+	"HTTP":  true,
+	"HTTPS": true,
+	"ID":    true,
+	"IP":    true,
+	"JSON":  true,
+	"QPS":   true,
+	"RAM":   true,
+	"RPC":   true,
+	"SLA":   true,
+	"SMTP":  true,
+	"SQL":   true,
+	"SSH":   true,
+	"TCP":   true,
+	"TLS":   true,
+	"TTL":   true,
+	"UDP":   true,
+	"UI":    true,
+	"UID":   true,
+	"URI":   true,
+	"URL":   true,
+	"UTF8":  true,
+	"UUID":  true,
+	"VM":    true,
+	"XML":   true,
+	"XSRF":  true,
+	"XSS":   true,
+}
+
+// camelCase joins the words of a snake_case or kebab-case name into a camel
+// case Go name, so "first_name" becomes "FirstName" and "user_id" "UserID".
+// Initialisms are upper cased, other words keep their case after the first
+// letter.
+func (g *CodeGen) camelCase(name string) string {
+	words := strings.FieldsFunc(name, func(r rune) bool {
+		return r == '_' || r == '-'
+	})
+	if len(words) == 0 {
+		return g.capitalise(name)
+	}
+
+	camel := &strings.Builder{}
+	for _, word := range words {
+		if upper := strings.ToUpper(word); initialisms[upper] {
+			camel.WriteString(upper)
+			continue
+		}
+		camel.WriteString(g.capitalise(word))
+	}
+	return camel.String()
+}
+
+func hasAnySuffix(word string, suffixes ...string) bool {
+	for _, suffix := range suffixes {
+		if strings.HasSuffix(word, suffix) {
+			return true
+		}
+	}
+	return false
+}
+
+func isVowel(c byte) bool {
+	return strings.IndexByte("aeiou", c) >= 0
+}
@@ -0,0 +1,81 @@
+package codegen
+
+import (
+	"testing"
+
+	"github.com/Applifier/graphql-codegen/config"
+)
+
+func TestInflect(t *testing.T) {
+	tests := []struct {
+		singular string
+		plural   string
+	}{
+		{"node", "nodes"},
+		{"Node", "Nodes"},
+		{"edge", "edges"},
+		{"person", "people"},
+		{"Person", "People"},
+		{"child", "children"},
+		{"man", "men"},
+		{"woman", "women"},
+		{"mouse", "mice"},
+		{"leaf", "leaves"},
+		{"index", "indices"},
+		{"sheep", "sheep"},
+		{"news", "news"},
+		{"category", "categories"},
+		{"day", "days"},
+		{"box", "boxes"},
+		{"match", "matches"},
+		{"address", "addresses"},
+		{"friendPerson", "friendPeople"},
+		{"userChild", "userChildren"},
+		{"pageInfo", "pageInfos"},
+	}
+
+	g := NewCodeGen("", config.Config{})
+	for _, test := range tests {
+		if result := g.pluralize(test.singular); result != test.plural {
+			t.Errorf("pluralize(%q) = %q, expected %q", test.singular, result, test.plural)
+		}
+		if result := g.singularize(test.plural); result != test.singular {
+			t.Errorf("singularize(%q) = %q, expected %q", test.plural, result, test.singular)
+		}
+	}
+
+	for _, word := range []string{"", "status", "class"} {
+		if result := g.singularize(word); result != word {
+			t.Errorf("singularize(%q) = %q, expected it unchanged", word, result)
+		}
+	}
+}
+
+func TestCamelCase(t *testing.T) {
+	tests := []struct {
+		name     string
+		expected string
+	}{
+		{"name", "Name"},
+		{"firstName", "FirstName"},
+		{"first_name", "FirstName"},
+		{"first-name", "FirstName"},
+		{"user_id", "UserID"},
+		{"id", "ID"},
+		{"avatar_url", "AvatarURL"},
+		{"http_status_code", "HTTPStatusCode"},
+		{"_private_field", "PrivateField"},
+		{"trailing__", "Trailing"},
+		{"user_Id", "UserID"},
+		{"createdAt_utc", "CreatedAtUtc"},
+		{"__", "__"},
+		{"über_name", "ÜberName"},
+	}
+
+	g := NewCodeGen("", config.Config{})
+	for _, test := range tests {
+		if result := g.camelCase(test.name); result != test.expected {
+			t.Errorf("camelCase(%q) = %q, expected %q", test.name, result, test.expected)
+		}
+	}
+}
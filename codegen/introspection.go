@@ -0,0 +1,273 @@
+package codegen
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/Applifier/graphql-codegen/config"
+)
+
+// GenerateFromIntrospection generates code from the JSON result of an
+// introspection query. The schema is rebuilt as SDL so the output is the same
+// as generating from the original schema file.
+func GenerateFromIntrospection(introspectionJSON []byte, conf config.Config) (map[string]string, error) {
+	schema, err := introspectionSchema(introspectionJSON)
+	if err != nil {
+		return nil, err
+	}
+	return NewCodeGen(schema, conf).Generate()
+}
+
+type introspectionResult struct {
+	Data   *introspectionResult `json:"data"`
+	Schema *struct {
+		QueryType        *introspectionTypeRef `json:"queryType"`
+		MutationType     *introspectionTypeRef `json:"mutationType"`
+		SubscriptionType *introspectionTypeRef `json:"subscriptionType"`
+		Types            []introspectionType   `json:"types"`
+	} `json:"__schema"`
+}
+
+type introspectionTypeRef struct {
+	Kind   string                `json:"kind"`
+	Name   *string               `json:"name"`
+	OfType *introspectionTypeRef `json:"ofType"`
+}
+
+type introspectionType struct {
+	Kind          string                 `json:"kind"`
+	Name          string                 `json:"name"`
+	Description   *string                `json:"description"`
+	Fields        []introspectionField   `json:"fields"`
+	InputFields   []introspectionInput   `json:"inputFields"`
+	Interfaces    []introspectionTypeRef `json:"interfaces"`
+	EnumValues    []introspectionEnum    `json:"enumValues"`
+	PossibleTypes []introspectionTypeRef `json:"possibleTypes"`
+}
+
+type introspectionField struct {
+	Name              string               `json:"name"`
+	Description       *string              `json:"description"`
+	Args              []introspectionInput `json:"args"`
+	Type              introspectionTypeRef `json:"type"`
+	IsDeprecated      bool                 `json:"isDeprecated"`
+	DeprecationReason *string              `json:"deprecationReason"`
+}
+
+type introspectionInput struct {
+	Name         string               `json:"name"`
+	Description  *string              `json:"description"`
+	Type         introspectionTypeRef `json:"type"`
+	DefaultValue *string              `json:"defaultValue"`
+}
+
+type introspectionEnum struct {
+	Name              string  `json:"name"`
+	Description       *string `json:"description"`
+	IsDeprecated      bool    `json:"isDeprecated"`
+	DeprecationReason *string `json:"deprecationReason"`
+}
+
+var builtinScalars = map[string]bool{
+	"Int":     true,
+	"Float":   true,
+	"String":  true,
+	"Boolean": true,
+	"ID":      true,
+}
+
+// introspectionSchema rebuilds the SDL of a schema from an introspection
+// result, with or without the surrounding "data" object
+func introspectionSchema(introspectionJSON []byte) (string, error) {
+	var result introspectionResult
+	if err := json.Unmarshal(introspectionJSON, &result); err != nil {
+		return "", fmt.Errorf("invalid introspection result: %v", err)
+	}
+	if result.Data != nil {
+		result = *result.Data
+	}
+	if result.Schema == nil {
+		return "", fmt.Errorf("invalid introspection result: missing __schema")
+	}
+
+	sdl := &strings.Builder{}
+	sdl.WriteString("schema {\n")
+	for _, op := range []struct {
+		name string
+		ref  *introspectionTypeRef
+	}{
+		{"query", result.Schema.QueryType},
+		{"mutation", result.Schema.MutationType},
+		{"subscription", result.Schema.SubscriptionType},
+	} {
+		if op.ref != nil && op.ref.Name != nil {
+			fmt.Fprintf(sdl, "\t%s: %s\n", op.name, *op.ref.Name)
+		}
+	}
+	sdl.WriteString("}\n")
+
+	for _, tp := range orderTypes(result.Schema.Types) {
+		if strings.HasPrefix(tp.Name, "__") || builtinScalars[tp.Name] {
+			continue
+		}
+
+		sdl.WriteString("\n")
+		writeDescription(sdl, "", tp.Description)
+		switch tp.Kind {
+		case "SCALAR":
+			fmt.Fprintf(sdl, "scalar %s\n", tp.Name)
+		case "OBJECT", "INTERFACE":
+			keyword := "type"
+			if tp.Kind == "INTERFACE" {
+				keyword = "interface"
+			}
+			fmt.Fprintf(sdl, "%s %s", keyword, tp.Name)
+			if len(tp.Interfaces) > 0 {
+				names := make([]string, len(tp.Interfaces))
+				for i, intf := range tp.Interfaces {
+					names[i] = typeRefString(intf)
+				}
+				fmt.Fprintf(sdl, " implements %s", strings.Join(names, ", "))
+			}
+			sdl.WriteString(" {\n")
+			for _, field := range tp.Fields {
+				writeDescription(sdl, "\t", field.Description)
+				fmt.Fprintf(sdl, "\t%s%s: %s%s\n", field.Name, argumentsString(field.Args), typeRefString(field.Type), deprecatedString(field.IsDeprecated, field.DeprecationReason))
+			}
+			sdl.WriteString("}\n")
+		case "UNION":
+			names := make([]string, len(tp.PossibleTypes))
+			for i, possibleType := range tp.PossibleTypes {
+				names[i] = typeRefString(possibleType)
+			}
+			fmt.Fprintf(sdl, "union %s = %s\n", tp.Name, strings.Join(names, " | "))
+		case "ENUM":
+			fmt.Fprintf(sdl, "enum %s {\n", tp.Name)
+			for _, value := range tp.EnumValues {
+				writeDescription(sdl, "\t", value.Description)
+				fmt.Fprintf(sdl, "\t%s%s\n", value.Name, deprecatedString(value.IsDeprecated, value.DeprecationReason))
+			}
+			sdl.WriteString("}\n")
+		case "INPUT_OBJECT":
+			fmt.Fprintf(sdl, "input %s {\n", tp.Name)
+			for _, field := range tp.InputFields {
+				writeDescription(sdl, "\t", field.Description)
+				fmt.Fprintf(sdl, "\t%s\n", inputValueString(field))
+			}
+			sdl.WriteString("}\n")
+		default:
+			return "", fmt.Errorf("invalid introspection result: unknown kind %q of type %s", tp.Kind, tp.Name)
+		}
+	}
+
+	return sdl.String(), nil
+}
+
+// orderTypes orders the types so the implementations of every interface are
+// declared in the order listed in its possible types. The parser reports
+// possible types in declaration order, so parsing the SDL again keeps it.
+func orderTypes(types []introspectionType) []introspectionType {
+	index := map[string]int{}
+	for i, tp := range types {
+		index[tp.Name] = i
+	}
+
+	after := make([][]int, len(types))
+	inDegree := make([]int, len(types))
+	for _, tp := range types {
+		if tp.Kind != "INTERFACE" {
+			continue
+		}
+		for i := 1; i < len(tp.PossibleTypes); i++ {
+			prev, prevOK := index[typeRefString(tp.PossibleTypes[i-1])]
+			next, nextOK := index[typeRefString(tp.PossibleTypes[i])]
+			if prevOK && nextOK {
+				after[prev] = append(after[prev], next)
+				inDegree[next]++
+			}
+		}
+	}
+
+	ordered := make([]introspectionType, 0, len(types))
+	done := make([]bool, len(types))
+	for len(ordered) < len(types) {
+		next := -1
+		for i := range types {
+			if !done[i] && inDegree[i] == 0 {
+				next = i
+				break
+			}
+		}
+		if next < 0 {
+			// Inconsistent possible types, keep the remaining types as they are
+			for i := range types {
+				if !done[i] {
+					ordered = append(ordered, types[i])
+				}
+			}
+			break
+		}
+
+		done[next] = true
+		ordered = append(ordered, types[next])
+		for _, i := range after[next] {
+			inDegree[i]--
+		}
+	}
+	return ordered
+}
+
+func writeDescription(sdl *strings.Builder, indent string, description *string) {
+	if description == nil || *description == "" {
+		return
+	}
+	for _, line := range strings.Split(*description, "\n") {
+		fmt.Fprintf(sdl, "%s# %s\n", indent, line)
+	}
+}
+
+func typeRefString(ref introspectionTypeRef) string {
+	if ref.OfType != nil {
+		switch ref.Kind {
+		case "NON_NULL":
+			return typeRefString(*ref.OfType) + "!"
+		case "LIST":
+			return "[" + typeRefString(*ref.OfType) + "]"
+		}
+	}
+	if ref.Name == nil {
+		return ""
+	}
+	return *ref.Name
+}
+
+func argumentsString(args []introspectionInput) string {
+	if len(args) == 0 {
+		return ""
+	}
+	values := make([]string, len(args))
+	for i, arg := range args {
+		values[i] = inputValueString(arg)
+	}
+	return "(" + strings.Join(values, ", ") + ")"
+}
+
+func inputValueString(input introspectionInput) string {
+	value := input.Name + ": " + typeRefString(input.Type)
+	if input.DefaultValue != nil {
+		value += " = " + *input.DefaultValue
+	}
+	return value
+}
+
+func deprecatedString(isDeprecated bool, reason *string) string {
+	if !isDeprecated {
+		return ""
+	}
+	if reason == nil {
+		return " @deprecated"
+	}
+	quoted, _ := json.Marshal(*reason)
+	return fmt.Sprintf(" @deprecated(reason: %s)", quoted)
+}
@@ -0,0 +1,168 @@
+package codegen
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"path"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/Applifier/graphql-codegen/config"
+	graphql "github.com/neelance/graphql-go"
+	"github.com/neelance/graphql-go/introspection"
+)
+
+func TestGenerateFromIntrospection(t *testing.T) {
+	testDirs, err := ioutil.ReadDir(fixtureDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, testDir := range testDirs {
+		if strings.HasPrefix(testDir.Name(), "_") {
+			continue
+		}
+
+		schemaBytes, _ := ioutil.ReadFile(path.Join(fixtureDir, testDir.Name(), "schema.graphql"))
+		confBytes, _ := ioutil.ReadFile(path.Join(fixtureDir, testDir.Name(), "config.hcl"))
+		cfg, err := config.Parse(string(confBytes))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		expected, err := NewCodeGen(string(schemaBytes), cfg).Generate()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		result, err := GenerateFromIntrospection(introspectionJSON(t, string(schemaBytes)), cfg)
+		if err != nil {
+			t.Fatalf("%s: %v", testDir.Name(), err)
+		}
+
+		// The schema constant holds the SDL given, which is rebuilt from the introspection
+		delete(result, "schema_gen.go")
+		delete(expected, "schema_gen.go")
+
+		if !reflect.DeepEqual(result, expected) {
+			t.Errorf("%s: generating from introspection should match generating from the schema", testDir.Name())
+		}
+	}
+}
+
+func TestGenerateFromIntrospectionInvalid(t *testing.T) {
+	for _, data := range []string{`{`, `{"data": {}}`} {
+		if _, err := GenerateFromIntrospection([]byte(data), config.Config{}); err == nil {
+			t.Errorf("Expected an error for %s", data)
+		}
+	}
+}
+
+// introspectionJSON returns the result of an introspection query for schema
+func introspectionJSON(t *testing.T, schema string) []byte {
+	sch, err := graphql.ParseSchema(schema, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ins := sch.Inspect()
+
+	types := []interface{}{}
+	for _, tp := range ins.Types() {
+		types = append(types, introspectionTypeJSON(tp))
+	}
+
+	data, err := json.Marshal(map[string]interface{}{
+		"data": map[string]interface{}{
+			"__schema": map[string]interface{}{
+				"queryType":        introspectionTypeRefJSON(ins.QueryType()),
+				"mutationType":     introspectionTypeRefJSON(ins.MutationType()),
+				"subscriptionType": introspectionTypeRefJSON(ins.SubscriptionType()),
+				"types":            types,
+			},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return data
+}
+
+func introspectionTypeRefJSON(tp *introspection.Type) interface{} {
+	if tp == nil {
+		return nil
+	}
+	return map[string]interface{}{
+		"kind":   tp.Kind(),
+		"name":   tp.Name(),
+		"ofType": introspectionTypeRefJSON(tp.OfType()),
+	}
+}
+
+func introspectionInputsJSON(inputs []*introspection.InputValue) []interface{} {
+	values := []interface{}{}
+	for _, input := range inputs {
+		values = append(values, map[string]interface{}{
+			"name":         input.Name(),
+			"description":  input.Description(),
+			"type":         introspectionTypeRefJSON(input.Type()),
+			"defaultValue": input.DefaultValue(),
+		})
+	}
+	return values
+}
+
+func introspectionTypesJSON(types *[]*introspection.Type) []interface{} {
+	if types == nil {
+		return nil
+	}
+	refs := []interface{}{}
+	for _, tp := range *types {
+		refs = append(refs, introspectionTypeRefJSON(tp))
+	}
+	return refs
+}
+
+func introspectionTypeJSON(tp *introspection.Type) interface{} {
+	all := &struct{ IncludeDeprecated bool }{true}
+	result := map[string]interface{}{
+		"kind":          tp.Kind(),
+		"name":          tp.Name(),
+		"description":   tp.Description(),
+		"interfaces":    introspectionTypesJSON(tp.Interfaces()),
+		"possibleTypes": introspectionTypesJSON(tp.PossibleTypes()),
+	}
+
+	if fields := tp.Fields(all); fields != nil {
+		values := []interface{}{}
+		for _, field := range *fields {
+			values = append(values, map[string]interface{}{
+				"name":              field.Name(),
+				"description":       field.Description(),
+				"args":              introspectionInputsJSON(field.Args()),
+				"type":              introspectionTypeRefJSON(field.Type()),
+				"isDeprecated":      field.IsDeprecated(),
+				"deprecationReason": field.DeprecationReason(),
+			})
+		}
+		result["fields"] = values
+	}
+
+	if inputFields := tp.InputFields(); inputFields != nil {
+		result["inputFields"] = introspectionInputsJSON(*inputFields)
+	}
+
+	if enumValues := tp.EnumValues(all); enumValues != nil {
+		values := []interface{}{}
+		for _, value := range *enumValues {
+			values = append(values, map[string]interface{}{
+				"name":              value.Name(),
+				"description":       value.Description(),
+				"isDeprecated":      value.IsDeprecated(),
+				"deprecationReason": value.DeprecationReason(),
+			})
+		}
+		result["enumValues"] = values
+	}
+
+	return result
+}
@@ -0,0 +1,70 @@
+package codegen
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/Applifier/graphql-codegen/config"
+)
+
+// defaultNamer names the generated code after the naming config, type_prefix,
+// camel_case, resolver_suffix and file_naming
+type defaultNamer struct {
+	g *CodeGen
+}
+
+// DefaultNamer returns the Namer used when conf.Namer is not set. A custom
+// Namer can embed it to change only some of the names.
+func DefaultNamer(conf config.Config) config.Namer {
+	return defaultNamer{g: NewCodeGen("", conf)}
+}
+
+// TypeName prefixes the schema name with conf.TypePrefix. The __ of the
+// introspection types is left out.
+func (n defaultNamer) TypeName(gqlName string) string {
+	return n.g.conf.TypePrefix + strings.TrimPrefix(gqlName, "__")
+}
+
+// FieldName capitalises the schema name, or camel cases it when
+// conf.CamelCase is set
+func (n defaultNamer) FieldName(gqlName string) string {
+	if n.g.conf.CamelCase {
+		return n.g.camelCase(gqlName)
+	}
+	return n.g.capitalise(gqlName)
+}
+
+// FileName executes the conf.FileNaming template
+func (n defaultNamer) FileName(goName string, kind string, suffix string) (string, error) {
+	tmpl, err := n.g.fileNameTemplate(n.g.conf.FileNaming)
+	if err != nil {
+		return "", err
+	}
+	buf := &bytes.Buffer{}
+	err = tmpl.Execute(buf, map[string]interface{}{
+		"Name":   goName,
+		"Kind":   kind,
+		"Suffix": suffix,
+	})
+	if err != nil {
+		return "", fmt.Errorf("file_naming for %s: %v", goName, err)
+	}
+	return buf.String(), nil
+}
+
+// ResolverName appends conf.ResolverSuffix, Resolver by default
+func (n defaultNamer) ResolverName(goName string) string {
+	if n.g.conf.ResolverSuffix != nil {
+		return goName + *n.g.conf.ResolverSuffix
+	}
+	return goName + "Resolver"
+}
+
+// namer returns conf.Namer or the default one
+func (g *CodeGen) namer() config.Namer {
+	if g.conf.Namer != nil {
+		return g.conf.Namer
+	}
+	return defaultNamer{g: g}
+}
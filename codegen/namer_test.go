@@ -0,0 +1,98 @@
+package codegen
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/Applifier/graphql-codegen/config"
+)
+
+// testNamer prefixes the types with Gql and names the files gen_<name>.go,
+// keeping the default field names
+type testNamer struct {
+	config.Namer
+}
+
+func (n testNamer) TypeName(gqlName string) string {
+	return "Gql" + gqlName
+}
+
+func (n testNamer) FileName(goName string, kind string, suffix string) (string, error) {
+	return "gen_" + strings.ToLower(goName) + ".go", nil
+}
+
+func (n testNamer) ResolverName(goName string) string {
+	return goName + "Res"
+}
+
+func TestNamer(t *testing.T) {
+	schema := `
+		type Query {
+			user(user_id: ID!): User
+		}
+
+		type User {
+			name: String!
+			best_friend: User
+		}
+	`
+
+	conf := config.Config{CamelCase: true}
+	conf.Namer = testNamer{Namer: DefaultNamer(conf)}
+	fileMap, err := NewCodeGen(schema, conf).Generate()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if names := sortedFileNames(fileMap); strings.Join(names, " ") != "gen_gqlquery.go gen_gqlresolver.go gen_gqluser.go" {
+		t.Fatalf("Expected the files named by the namer, got %v", names)
+	}
+
+	for fileName, expected := range map[string][]string{
+		"gen_gqluser.go": {
+			"type GqlUser struct {",
+			"type GqlUserRes struct {",
+			"BestFriend *GqlUser",
+			"func (r *GqlUserRes) BestFriend() *GqlUserRes {",
+		},
+		"gen_gqlquery.go": {
+			"type GqlQueryUserArgs struct {",
+			"UserID graphql.ID",
+			"func (r *GqlResolver) User(args *GqlQueryUserArgs) *GqlUserRes {",
+		},
+		"gen_gqlresolver.go": {
+			"type GqlResolver struct {",
+		},
+	} {
+		for _, exp := range expected {
+			if !strings.Contains(fileMap[fileName], exp) {
+				t.Errorf("Expected %s in %s\n%s", exp, fileName, fileMap[fileName])
+			}
+		}
+	}
+}
+
+func TestNamerErrors(t *testing.T) {
+	schema := `
+		type Query {
+			user: User
+		}
+
+		type User {
+			name: String
+		}
+	`
+	conf := config.Config{}
+	conf.Namer = invalidNamer{Namer: DefaultNamer(conf)}
+	if _, err := NewCodeGen(schema, conf).Generate(); err == nil || !strings.Contains(err.Error(), "from the namer") {
+		t.Errorf("Expected an error for an invalid type name, got %v", err)
+	}
+}
+
+// invalidNamer names the types with a dash
+type invalidNamer struct {
+	config.Namer
+}
+
+func (n invalidNamer) TypeName(gqlName string) string {
+	return "my-" + gqlName
+}
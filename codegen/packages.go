@@ -0,0 +1,157 @@
+package codegen
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/Applifier/graphql-codegen/config"
+	"github.com/neelance/graphql-go/introspection"
+)
+
+// packageImport is the first reference found of a type to a type generated
+// into another package, which makes the package of the one import the other
+type packageImport struct {
+	from, to string
+}
+
+// namedType returns the named type of a list or non-null type
+func namedType(tp *introspection.Type) *introspection.Type {
+	for tp.Kind() == "NON_NULL" || tp.Kind() == "LIST" {
+		tp = tp.OfType()
+	}
+	return tp
+}
+
+// checkImportCycles makes sure the packages the types are generated into do
+// not import each other, which Go does not allow. A type imports the package
+// of the types of its fields, arguments and input fields, of the members of a
+// union or interface and, with assert_interfaces, of its interfaces.
+func (g *CodeGen) checkImportCycles(types []*introspection.Type, conf config.Config) error {
+	planned := map[string]bool{}
+	for _, tp := range types {
+		planned[*tp.Name()] = true
+	}
+
+	// imports maps the directory of a package to the directories it imports
+	imports := map[string]map[string]packageImport{}
+	packageNames := map[string]string{}
+	addImport := func(from string, to *introspection.Type) {
+		to = namedType(to)
+		if to.Name() == nil || !planned[*to.Name()] {
+			return
+		}
+		fromPkg, fromDir := g.typePackage(from, conf)
+		toPkg, toDir := g.typePackage(*to.Name(), conf)
+		if fromDir == toDir {
+			return
+		}
+		packageNames[fromDir], packageNames[toDir] = fromPkg, toPkg
+		if imports[fromDir] == nil {
+			imports[fromDir] = map[string]packageImport{}
+		}
+		if _, ok := imports[fromDir][toDir]; !ok {
+			imports[fromDir][toDir] = packageImport{from: from, to: *to.Name()}
+		}
+	}
+
+	for _, tp := range types {
+		name := *tp.Name()
+		if tp.Fields(&struct{ IncludeDeprecated bool }{true}) != nil {
+			for _, fp := range *tp.Fields(&struct{ IncludeDeprecated bool }{true}) {
+				addImport(name, fp.Type())
+				for _, arg := range fp.Args() {
+					addImport(name, arg.Type())
+				}
+			}
+		}
+		if tp.InputFields() != nil {
+			for _, ip := range *tp.InputFields() {
+				addImport(name, ip.Type())
+			}
+		}
+		if tp.PossibleTypes() != nil {
+			for _, possibleType := range *tp.PossibleTypes() {
+				addImport(name, possibleType)
+			}
+		}
+		if conf.AssertInterfaces && tp.Interfaces() != nil {
+			for _, intf := range *tp.Interfaces() {
+				addImport(name, intf)
+			}
+		}
+	}
+
+	dirs := make([]string, 0, len(imports))
+	for dir := range imports {
+		dirs = append(dirs, dir)
+	}
+	sort.Strings(dirs)
+
+	// A depth first search, the packages on the stack import each other when
+	// one of them is imported again
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+	state := map[string]int{}
+	var stack []string
+	var visit func(dir string) error
+	visit = func(dir string) error {
+		state[dir] = visiting
+		stack = append(stack, dir)
+		imported := make([]string, 0, len(imports[dir]))
+		for to := range imports[dir] {
+			imported = append(imported, to)
+		}
+		sort.Strings(imported)
+		for _, to := range imported {
+			switch state[to] {
+			case visiting:
+				return importCycleError(stack, to, imports, packageNames)
+			case unvisited:
+				if err := visit(to); err != nil {
+					return err
+				}
+			}
+		}
+		stack = stack[:len(stack)-1]
+		state[dir] = visited
+		return nil
+	}
+	for _, dir := range dirs {
+		if state[dir] == unvisited {
+			if err := visit(dir); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// importCycleError names the packages of the cycle closed by importing to and
+// the types whose references make them import each other
+func importCycleError(stack []string, to string, imports map[string]map[string]packageImport, packageNames map[string]string) error {
+	var cycle []string
+	for i, dir := range stack {
+		if dir == to {
+			cycle = stack[i:]
+			break
+		}
+	}
+
+	pkgs := make([]string, 0, len(cycle)+1)
+	refs := make([]string, 0, len(cycle))
+	for i, dir := range cycle {
+		next := to
+		if i+1 < len(cycle) {
+			next = cycle[i+1]
+		}
+		ref := imports[dir][next]
+		pkgs = append(pkgs, packageNames[dir])
+		refs = append(refs, fmt.Sprintf("%s refers to %s", ref.from, ref.to))
+	}
+	pkgs = append(pkgs, packageNames[to])
+	return fmt.Errorf("import cycle between the packages %s: %s, generate the types into the same package", strings.Join(pkgs, " -> "), strings.Join(refs, ", "))
+}
@@ -0,0 +1,41 @@
+package codegen
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/Applifier/graphql-codegen/config"
+)
+
+func TestImportCycles(t *testing.T) {
+	schema := `
+		type Query {
+			user: User
+		}
+
+		type User {
+			name: String!
+			posts: [Post]
+		}
+
+		type Post {
+			title: String!
+			author: User
+		}
+	`
+	conf := config.Config{
+		Package:    "resolvers",
+		ImportPath: "github.com/example/api/resolvers",
+		Type:       map[string]config.TypeConfig{"Post": {Package: "models"}},
+	}
+	_, err := NewCodeGen(schema, conf).Generate()
+	if err == nil || !strings.Contains(err.Error(), "import cycle between the packages resolvers -> models -> resolvers: User refers to Post, Post refers to User") {
+		t.Errorf("Expected an error naming the types on the import cycle, got %v", err)
+	}
+
+	// Without the reference back the root package only imports models
+	acyclic := strings.Replace(schema, "author: User", "author: String", 1)
+	if _, err := NewCodeGen(acyclic, conf).Generate(); err != nil {
+		t.Errorf("Expected no import cycle, got %v", err)
+	}
+}
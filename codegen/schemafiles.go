@@ -0,0 +1,398 @@
+package codegen
+
+import (
+	"fmt"
+	"io/ioutil"
+	"path"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/Applifier/graphql-codegen/config"
+)
+
+// GenerateFromFiles generates code for a schema split across several SDL
+// files. Definitions starting with `extend` are merged into the type they
+// extend, or the schema definition for `extend schema`, so the order of the
+// files does not matter. Extensions without a body only add the interfaces
+// they implement, like `extend type A implements Node`, the union and scalar
+// ones, like `extend union U = A`, are reported as unsupported.
+func GenerateFromFiles(paths []string, conf config.Config) (map[string]string, error) {
+	files := make([]schemaFile, len(paths))
+	sources := make([]string, len(paths))
+	for i, filePath := range paths {
+		fileBytes, err := ioutil.ReadFile(filePath)
+		if err != nil {
+			return nil, err
+		}
+		files[i] = schemaFile{name: filePath, src: string(fileBytes)}
+		sources[i] = path.Base(filePath)
+	}
+
+	schema, lines, err := stitchSchema(files)
+	if err != nil {
+		return nil, err
+	}
+
+	g := NewCodeGen(schema, conf)
+	g.SetSource(strings.Join(sources, ", "))
+	results, err := g.Generate()
+	if err != nil {
+		return nil, lines.locate(err)
+	}
+	return results, nil
+}
+
+type schemaFile struct {
+	name string
+	src  string
+}
+
+// sdlDefinition is a top level definition found in a schema file
+type sdlDefinition struct {
+	extend bool
+	kind   string
+	name   string
+	// header is the text between the name and the opening brace
+	header string
+	// start is the offset of the first keyword, end the offset after the closing brace
+	start, end int
+	// open and close are the offsets of the braces of the body
+	open, close int
+	nameEnd     int
+	// bodyless is set for an extension without a body, like extend union U = A,
+	// which ends at end
+	bodyless bool
+}
+
+// key returns the name definitions are merged by, schema for the schema
+// definition which has no name
+func (def sdlDefinition) key() string {
+	if def.kind == "schema" {
+		return "schema"
+	}
+	return def.name
+}
+
+// describe names the definition in errors
+func (def sdlDefinition) describe() string {
+	if def.kind == "schema" {
+		return "schema"
+	}
+	return fmt.Sprintf("%s %q", def.kind, def.name)
+}
+
+var definitionKeywords = map[string]bool{
+	"schema":    true,
+	"type":      true,
+	"interface": true,
+	"input":     true,
+	"enum":      true,
+	"union":     true,
+	"scalar":    true,
+	"directive": true,
+	"extend":    true,
+}
+
+// scanDefinitions returns the top level definitions of src that have a body,
+// and the extensions without one marked bodyless
+func scanDefinitions(src string) []sdlDefinition {
+	var defs []sdlDefinition
+	var cur *sdlDefinition
+	depth := 0
+
+	for i := 0; i < len(src); i++ {
+		c := src[i]
+		switch {
+		case c == '#':
+			for i < len(src) && src[i] != '\n' {
+				i++
+			}
+		case c == '"':
+			i = skipString(src, i)
+		case c == '{':
+			depth++
+			if depth == 1 && cur != nil {
+				cur.open = i
+				cur.header = src[cur.nameEnd:i]
+			}
+		case c == '}':
+			depth--
+			if depth == 0 && cur != nil {
+				cur.close = i
+				cur.end = i + 1
+				defs = append(defs, *cur)
+				cur = nil
+			}
+		case depth == 0 && isNameStart(c):
+			start := i
+			for i+1 < len(src) && isNameChar(src[i+1]) {
+				i++
+			}
+			word := src[start : i+1]
+			// Directive names, e.g. of schema @link, are not definition names
+			if start > 0 && src[start-1] == '@' {
+				continue
+			}
+
+			switch {
+			case cur == nil || ((cur.name != "" || cur.kind == "schema") && definitionKeywords[word]):
+				if !definitionKeywords[word] {
+					continue
+				}
+				if cur != nil && cur.extend {
+					defs = append(defs, cur.withoutBody(src, commentStart(src, start)))
+				}
+				cur = &sdlDefinition{start: start}
+				if word == "extend" {
+					cur.extend = true
+				} else {
+					cur.kind = word
+				}
+			case cur.kind == "":
+				cur.kind = word
+				if word == "schema" {
+					cur.nameEnd = i + 1
+				}
+			case cur.name == "" && cur.kind != "schema":
+				cur.name = word
+				cur.nameEnd = i + 1
+			}
+		}
+	}
+	if cur != nil && cur.extend {
+		defs = append(defs, cur.withoutBody(src, len(src)))
+	}
+	return defs
+}
+
+// withoutBody returns the extension ending at end as bodyless, the text after
+// its name being the header, e.g. implements Node
+func (def sdlDefinition) withoutBody(src string, end int) sdlDefinition {
+	def.bodyless = true
+	def.end = end
+	if def.nameEnd > 0 && def.nameEnd <= end {
+		def.header = src[def.nameEnd:end]
+	}
+	return def
+}
+
+func skipString(src string, i int) int {
+	if strings.HasPrefix(src[i:], `"""`) {
+		if end := strings.Index(src[i+3:], `"""`); end >= 0 {
+			return i + 3 + end + 2
+		}
+		return len(src)
+	}
+	for i++; i < len(src) && src[i] != '"' && src[i] != '\n'; i++ {
+		if src[i] == '\\' {
+			i++
+		}
+	}
+	return i
+}
+
+func isNameStart(c byte) bool {
+	return c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
+func isNameChar(c byte) bool {
+	return isNameStart(c) || (c >= '0' && c <= '9')
+}
+
+// interfaces returns the interface names listed after implements in a header
+func interfaces(header string) []string {
+	fields := strings.FieldsFunc(header, func(r rune) bool {
+		return r == ' ' || r == '\t' || r == '\n' || r == '\r' || r == ',' || r == '&'
+	})
+	for i, field := range fields {
+		if field == "implements" {
+			return fields[i+1:]
+		}
+	}
+	return nil
+}
+
+// sourceLine is the file and line a line of the stitched schema came from
+type sourceLine struct {
+	file string
+	line int
+}
+
+type lineMap []sourceLine
+
+var locationPattern = regexp.MustCompile(` ?\(line (\d+)(?:, column (\d+))?\)`)
+
+// locate rewrites a line and column reported for the stitched schema to the
+// file and line the text originally came from
+func (lines lineMap) locate(err error) error {
+	match := locationPattern.FindStringSubmatch(err.Error())
+	if match == nil {
+		return err
+	}
+	line, _ := strconv.Atoi(match[1])
+	if line < 1 || line > len(lines) {
+		return err
+	}
+
+	source := lines[line-1]
+	message := strings.Replace(err.Error(), match[0], "", 1)
+	if match[2] != "" {
+		return fmt.Errorf("%s:%d:%s: %s", source.file, source.line, match[2], message)
+	}
+	return fmt.Errorf("%s:%d: %s", source.file, source.line, message)
+}
+
+// stitcher builds the stitched schema while remembering where each line came from
+type stitcher struct {
+	buf   strings.Builder
+	lines lineMap
+}
+
+func (s *stitcher) write(text string, file string, line int) {
+	if len(s.lines) == 0 {
+		s.lines = append(s.lines, sourceLine{file, line})
+	}
+	for i := 0; i < len(text); i++ {
+		s.buf.WriteByte(text[i])
+		if text[i] == '\n' {
+			line++
+			s.lines = append(s.lines, sourceLine{file, line})
+		}
+	}
+}
+
+func lineAt(src string, offset int) int {
+	return strings.Count(src[:offset], "\n") + 1
+}
+
+// commentStart moves offset back over the comment lines directly above it so
+// they are removed together with the definition
+func commentStart(src string, offset int) int {
+	start := strings.LastIndex(src[:offset], "\n") + 1
+	if strings.TrimSpace(src[start:offset]) != "" {
+		return offset
+	}
+	for start > 0 {
+		prev := strings.LastIndex(src[:start-1], "\n") + 1
+		if !strings.HasPrefix(strings.TrimSpace(src[prev:start-1]), "#") {
+			break
+		}
+		start = prev
+	}
+	return start
+}
+
+// schemaDescription returns the description of the schema definition of src,
+// the comment lines directly above it, or "" when there is none
+func schemaDescription(src string) string {
+	for _, def := range scanDefinitions(src) {
+		if def.kind != "schema" || def.extend {
+			continue
+		}
+		var lines []string
+		for _, line := range strings.Split(src[commentStart(src, def.start):def.start], "\n") {
+			lines = append(lines, strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(line), "#")))
+		}
+		return strings.TrimSpace(strings.Join(lines, "\n"))
+	}
+	return ""
+}
+
+type extension struct {
+	file *schemaFile
+	def  sdlDefinition
+}
+
+// stitchSchema concatenates the files, merging the body and interfaces of
+// every `extend` definition into the definition it extends, the operation
+// fields of `extend schema` into the schema definition. Extensions without a
+// body only add their interfaces, the members of a union or the directives of
+// a scalar are not supported.
+func stitchSchema(files []schemaFile) (string, lineMap, error) {
+	defs := make([][]sdlDefinition, len(files))
+	bases := map[string]sdlDefinition{}
+	extensions := map[string][]extension{}
+	for i := range files {
+		defs[i] = scanDefinitions(files[i].src)
+		for _, def := range defs[i] {
+			if def.extend {
+				extensions[def.key()] = append(extensions[def.key()], extension{&files[i], def})
+			} else if def.key() != "" {
+				bases[def.key()] = def
+			}
+		}
+	}
+
+	for i := range files {
+		for _, def := range defs[i] {
+			if !def.extend {
+				continue
+			}
+			if def.bodyless && (def.kind == "union" || def.kind == "scalar") {
+				return "", nil, fmt.Errorf("%s:%d: unsupported extension of %s without a body, only extensions adding fields, values or interfaces are merged", files[i].name, lineAt(files[i].src, def.start), def.describe())
+			}
+			base, ok := bases[def.key()]
+			if !ok && def.kind == "schema" {
+				return "", nil, fmt.Errorf("%s:%d: cannot extend the schema, there is no schema definition", files[i].name, lineAt(files[i].src, def.start))
+			}
+			if !ok {
+				return "", nil, fmt.Errorf("%s:%d: cannot extend unknown type %q", files[i].name, lineAt(files[i].src, def.start), def.name)
+			}
+			if def.kind != base.kind {
+				return "", nil, fmt.Errorf("%s:%d: cannot extend %s %q as %s", files[i].name, lineAt(files[i].src, def.start), base.kind, def.name, def.kind)
+			}
+		}
+	}
+
+	s := &stitcher{}
+	for i, file := range files {
+		cursor := 0
+		for _, def := range defs[i] {
+			if def.extend {
+				start := commentStart(file.src, def.start)
+				s.write(file.src[cursor:start], file.name, lineAt(file.src, cursor))
+				s.write(strings.Repeat("\n", strings.Count(file.src[start:def.end], "\n")), file.name, lineAt(file.src, start))
+				cursor = def.end
+				continue
+			}
+
+			exts := extensions[def.key()]
+			if len(exts) == 0 || def.key() == "" {
+				continue
+			}
+
+			s.write(file.src[cursor:def.open], file.name, lineAt(file.src, cursor))
+			implements := map[string]bool{}
+			for _, name := range interfaces(def.header) {
+				implements[name] = true
+			}
+			for _, ext := range exts {
+				for _, name := range interfaces(ext.def.header) {
+					if implements[name] {
+						continue
+					}
+					if len(implements) == 0 {
+						s.write("implements ", file.name, lineAt(file.src, def.open))
+					}
+					s.write(name+" ", file.name, lineAt(file.src, def.open))
+					implements[name] = true
+				}
+			}
+			s.write(file.src[def.open:def.close], file.name, lineAt(file.src, def.open))
+			for _, ext := range exts {
+				if ext.def.bodyless {
+					continue
+				}
+				s.write("\n", file.name, lineAt(file.src, def.close)-1)
+				s.write(ext.file.src[ext.def.open+1:ext.def.close], ext.file.name, lineAt(ext.file.src, ext.def.open))
+			}
+			s.write("\n", file.name, lineAt(file.src, def.close)-1)
+			cursor = def.close
+		}
+		s.write(file.src[cursor:], file.name, lineAt(file.src, cursor))
+		s.write("\n", file.name, lineAt(file.src, len(file.src)))
+	}
+
+	return s.buf.String(), s.lines, nil
+}
@@ -0,0 +1,202 @@
+package codegen
+
+import (
+	"io/ioutil"
+	"path"
+	"strings"
+	"testing"
+
+	"github.com/Applifier/graphql-codegen/config"
+)
+
+func writeSchemaFiles(t *testing.T, files map[string]string) string {
+	dir := t.TempDir()
+	for name, content := range files {
+		if err := ioutil.WriteFile(path.Join(dir, name), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return dir
+}
+
+func TestGenerateFromFiles(t *testing.T) {
+	dir := writeSchemaFiles(t, map[string]string{
+		"query.graphql": `
+schema {
+	query: Query
+}
+
+type Query {
+	user(id: ID!): User
+}
+`,
+		"user.graphql": `
+# A user of the service
+type User {
+	name: String!
+}
+
+interface Node {
+	id: ID!
+}
+`,
+		"extensions.graphql": `
+# Extensions are merged into the base type
+extend type User implements Node {
+	# The unique ID of the user
+	id: ID!
+}
+
+extend type Query {
+	viewer: User
+}
+`,
+	})
+
+	// The extensions are read before the types they extend
+	paths := []string{
+		path.Join(dir, "extensions.graphql"),
+		path.Join(dir, "query.graphql"),
+		path.Join(dir, "user.graphql"),
+	}
+	results, err := GenerateFromFiles(paths, config.Config{Package: "stitched"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	user := results["user_gen.go"]
+	for _, expected := range []string{
+		"// Code generated by graphql-codegen v" + Version + " from extensions.graphql, query.graphql, user.graphql. DO NOT EDIT.",
+		"// User A user of the service",
+		"// ID The unique ID of the user",
+		"func (r *UserResolver) ID() graphql.ID",
+	} {
+		if !strings.Contains(user, expected) {
+			t.Errorf("user_gen.go should contain %q\n%s", expected, user)
+		}
+	}
+
+	if query := results["query_gen.go"]; !strings.Contains(query, "Viewer() *UserResolver") {
+		t.Errorf("query_gen.go should contain the extended field\n%s", query)
+	}
+
+	if node := results["node_gen.go"]; !strings.Contains(node, "// Node is implemented by User") {
+		t.Errorf("node_gen.go should list User as an implementation\n%s", node)
+	}
+}
+
+func TestGenerateFromFilesSyntaxError(t *testing.T) {
+	dir := writeSchemaFiles(t, map[string]string{
+		"a.graphql": "type Query {\n\ta: String\n}\n",
+		"b.graphql": "type B {\n\tb: String\n\tc String\n}\n",
+	})
+
+	_, err := GenerateFromFiles([]string{path.Join(dir, "a.graphql"), path.Join(dir, "b.graphql")}, config.Config{})
+	if err == nil {
+		t.Fatal("Expected a syntax error")
+	}
+	if !strings.HasPrefix(err.Error(), path.Join(dir, "b.graphql")+":3:") {
+		t.Errorf("Error %q should point to line 3 of b.graphql", err)
+	}
+}
+
+func TestGenerateFromFilesUnknownExtension(t *testing.T) {
+	dir := writeSchemaFiles(t, map[string]string{
+		"a.graphql": "type Query {\n\ta: String\n}\n\nextend type Missing {\n\tb: String\n}\n",
+	})
+
+	_, err := GenerateFromFiles([]string{path.Join(dir, "a.graphql")}, config.Config{})
+	if err == nil || !strings.Contains(err.Error(), "a.graphql:5: cannot extend unknown type \"Missing\"") {
+		t.Errorf("Expected an error naming the file and the unknown type, got %v", err)
+	}
+}
+
+func TestGenerateFromFilesSchemaExtension(t *testing.T) {
+	dir := writeSchemaFiles(t, map[string]string{
+		"query.graphql":    "schema {\n\tquery: Query\n}\n\ntype Query {\n\ta: String\n}\n",
+		"mutation.graphql": "extend schema {\n\tmutation: Mutation\n}\n\ntype Mutation {\n\tsetA(a: String!): String\n}\n",
+	})
+
+	results, err := GenerateFromFiles([]string{path.Join(dir, "mutation.graphql"), path.Join(dir, "query.graphql")}, config.Config{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if mutation := results["mutation_gen.go"]; !strings.Contains(mutation, "func (r *Resolver) SetA(") {
+		t.Errorf("The mutation of the schema extension should be a root of the resolver\n%s", mutation)
+	}
+
+	dir = writeSchemaFiles(t, map[string]string{
+		"a.graphql": "type Query {\n\ta: String\n}\n\nextend schema {\n\tmutation: Query\n}\n",
+	})
+	if _, err := GenerateFromFiles([]string{path.Join(dir, "a.graphql")}, config.Config{}); err == nil || !strings.Contains(err.Error(), "a.graphql:5: cannot extend the schema") {
+		t.Errorf("Expected an error for extending a missing schema definition, got %v", err)
+	}
+}
+
+func TestGenerateFromFilesBodylessExtension(t *testing.T) {
+	for _, test := range []struct {
+		extension string
+		described string
+	}{
+		{"extend union Result = A | B", `union "Result"`},
+		{"extend scalar Time @deprecated", `scalar "Time"`},
+	} {
+		dir := writeSchemaFiles(t, map[string]string{
+			"a.graphql": "type Query {\n\ta: A\n}\n\n" + test.extension + "\n\ntype A {\n\tid: ID!\n}\n",
+		})
+		_, err := GenerateFromFiles([]string{path.Join(dir, "a.graphql")}, config.Config{})
+		if err == nil || !strings.Contains(err.Error(), "a.graphql:5: unsupported extension of "+test.described) {
+			t.Errorf("Expected an unsupported extension error for %s, got %v", test.extension, err)
+		}
+	}
+}
+
+func TestGenerateFromFilesInterfaceExtension(t *testing.T) {
+	files := []schemaFile{
+		{name: "a.graphql", src: "type Query {\n\ta: A\n}\n\n# A is a Node\nextend type A implements Node & Named\n\n# The type\ntype A {\n\tid: ID!\n\tname: String!\n}\n"},
+		{name: "node.graphql", src: "interface Node {\n\tid: ID!\n}\n\ninterface Named {\n\tname: String!\n}\n\nextend type A implements Node"},
+	}
+	schema, lines, err := stitchSchema(files)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, expected := range []string{"# The type\ntype A implements Node Named {\n", "type Query {\n\ta: A\n}\n\n\n\n\n# The type"} {
+		if !strings.Contains(schema, expected) {
+			t.Errorf("Expected %q in the stitched schema\n%s", expected, schema)
+		}
+	}
+	if strings.Contains(schema, "extend") {
+		t.Errorf("Expected the extensions to be removed\n%s", schema)
+	}
+	if len(lines) != strings.Count(schema, "\n")+1 {
+		t.Errorf("Expected a source line for each of the %d lines, got %d", strings.Count(schema, "\n")+1, len(lines))
+	}
+
+	dir := writeSchemaFiles(t, map[string]string{"a.graphql": files[0].src, "node.graphql": files[1].src})
+	results, err := GenerateFromFiles([]string{path.Join(dir, "a.graphql"), path.Join(dir, "node.graphql")}, config.Config{AssertInterfaces: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, expected := range []string{"var _ Node = &AResolver{}", "var _ Named = &AResolver{}"} {
+		if !strings.Contains(results["a_gen.go"], expected) {
+			t.Errorf("Expected A to implement the interfaces of the extension, missing %s\n%s", expected, results["a_gen.go"])
+		}
+	}
+}
+
+func TestSchemaDescription(t *testing.T) {
+	tests := map[string]string{
+		"type Query { hello: String }":                                                       "",
+		"schema {\n  query: Query\n}":                                                        "",
+		"# The shop\nschema {\n  query: Query\n}":                                            "The shop",
+		"# The shop,\n#   with carts\nschema { query: Query }":                               "The shop,\nwith carts",
+		"# Of the type\ntype Query { hello: String }\n\n# The shop\nschema { query: Query }": "The shop",
+		"# Detached\n\nschema { query: Query }":                                              "",
+	}
+
+	for src, expected := range tests {
+		if description := schemaDescription(src); description != expected {
+			t.Errorf("schemaDescription(%q) = %q, expected %q", src, description, expected)
+		}
+	}
+}
@@ -0,0 +1,94 @@
+package codegen
+
+import (
+	"bytes"
+	"fmt"
+	"go/parser"
+	"go/token"
+	"io"
+	"io/ioutil"
+
+	"github.com/Applifier/graphql-codegen/config"
+)
+
+// GenerateSingleFile generates code for the schema like Generate, into a
+// single file with one package clause and one import block
+func GenerateSingleFile(graphSchema string, conf config.Config) (string, error) {
+	return NewCodeGen(graphSchema, conf).GenerateSingleFile()
+}
+
+// GenerateSingleFile generates the files of the plan into one, in the order of
+// the plan. The types have to be generated into the root package, and seeded
+// resolvers are not supported as the file is regenerated as a whole. The
+// PostProcess hook is called once, for the single file, with an empty name.
+func (g *CodeGen) GenerateSingleFile() (string, error) {
+	conf, _, entries, err := g.plan()
+	if err != nil {
+		return "", err
+	}
+	for _, entry := range entries {
+		if entry.Seed {
+			return "", fmt.Errorf("seed_resolvers is not supported when generating a single file")
+		}
+		if entry.Loaders {
+			return "", fmt.Errorf("dataloader is not supported when generating a single file, the batch functions are seeded into a file of their own")
+		}
+		if entry.Kind == "RESOLVER" || entry.Kind == "SCHEMA" {
+			continue
+		}
+		if pkg, _ := g.typePackage(entry.TypeName, conf); pkg != conf.Package {
+			return "", fmt.Errorf("type %s is generated into package %s, a single file has only package %s", entry.TypeName, pkg, conf.Package)
+		}
+	}
+
+	var imports []string
+	body := &bytes.Buffer{}
+	err = g.generateFiles(nil, func(fileName string, r io.Reader) error {
+		code, err := ioutil.ReadAll(r)
+		if err != nil {
+			return err
+		}
+		fileImports, decls, err := splitImports(string(code))
+		if err != nil {
+			return fmt.Errorf("%s: %v", fileName, err)
+		}
+		imports = append(imports, fileImports...)
+		body.WriteString(decls)
+		body.WriteString("\n")
+		return nil
+	})
+	if err != nil {
+		return "", err
+	}
+
+	b, err := formatGenerated(g.docHeader(conf) + g.importBlock(imports) + "\n" + body.String())
+	if err != nil {
+		return "", err
+	}
+	if conf.PostProcess != nil {
+		if b, err = conf.PostProcess("", b); err != nil {
+			return "", fmt.Errorf("post processing the single file: %v", err)
+		}
+	}
+	return string(b), nil
+}
+
+// splitImports returns the import lines of a generated file and the code
+// following its package clause and import declarations
+func splitImports(code string) ([]string, string, error) {
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, "", code, parser.ImportsOnly)
+	if err != nil {
+		return nil, "", fmt.Errorf("generated code does not parse: %v", err)
+	}
+
+	imports := make([]string, 0, len(f.Imports))
+	for _, spec := range f.Imports {
+		imports = append(imports, importLine(spec))
+	}
+	end := f.Name.End()
+	if len(f.Decls) > 0 {
+		end = f.Decls[len(f.Decls)-1].End()
+	}
+	return imports, code[fset.Position(end).Offset:], nil
+}
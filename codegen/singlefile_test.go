@@ -0,0 +1,98 @@
+package codegen
+
+import (
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"path"
+	"strings"
+	"testing"
+
+	"github.com/Applifier/graphql-codegen/config"
+)
+
+func TestGenerateSingleFile(t *testing.T) {
+	schema, err := ioutil.ReadFile(path.Join(fixtureDir, "starwars", "schema.graphql"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	code, err := GenerateSingleFile(string(schema), config.Config{Package: "starwars", GenerateSchema: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, "", code, parser.ParseComments)
+	if err != nil {
+		t.Fatalf("The single file does not parse: %v\n%s", err, code)
+	}
+	if f.Name.Name != "starwars" || strings.Count(code, "\npackage ") != 1 {
+		t.Errorf("Expected a single package clause\n%s", code)
+	}
+	if strings.Count(code, "\nimport (") != 1 || len(f.Imports) != 3 {
+		t.Errorf("Expected a single block of the json, fmt and graphql-go imports\n%s", code)
+	}
+	if strings.Count(code, "DO NOT EDIT.") != 1 {
+		t.Errorf("Expected a single generated file marker\n%s", code)
+	}
+	for _, expected := range []string{"type Resolver struct", "type Human struct", "type Character interface", "const Schema = "} {
+		if !strings.Contains(code, expected) {
+			t.Errorf("Expected %s in\n%s", expected, code)
+		}
+	}
+}
+
+func TestGenerateSingleFilePackageDoc(t *testing.T) {
+	schema := `
+		# The API of the shop
+		schema {
+			query: Query
+		}
+
+		type Query {
+			hello: String
+		}
+	`
+
+	code, err := GenerateSingleFile(schema, config.Config{Package: "shop"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	f, err := parser.ParseFile(token.NewFileSet(), "", code, parser.ParseComments)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if f.Doc == nil || f.Doc.Text() != "Package shop The API of the shop\n" {
+		t.Errorf("Expected the schema description as the package doc comment\n%s", code)
+	}
+
+	code, err = GenerateSingleFile(strings.Replace(schema, "# The API of the shop", "", 1), config.Config{Package: "shop"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if f, err := parser.ParseFile(token.NewFileSet(), "", code, parser.ParseComments); err != nil || f.Doc != nil {
+		t.Errorf("Expected no package doc comment without a schema description, got %v\n%s", err, code)
+	}
+}
+
+func TestGenerateSingleFileErrors(t *testing.T) {
+	schema := `
+		type Query {
+			user: User
+		}
+
+		type User {
+			name: String!
+		}
+	`
+
+	for _, conf := range []config.Config{
+		{Type: map[string]config.TypeConfig{"User": {Package: "models"}}},
+		{SeedResolvers: true},
+	} {
+		if _, err := GenerateSingleFile(schema, conf); err == nil {
+			t.Errorf("Expected an error for %+v", conf)
+		}
+	}
+}
@@ -0,0 +1,112 @@
+package codegen
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strings"
+
+	"github.com/Applifier/graphql-codegen/config"
+	"github.com/neelance/graphql-go/introspection"
+)
+
+// GenerateTypes generates code for the named types of the schema like
+// Generate, leaving out the other types, the entry point and the schema
+// constant, e.g. to regenerate a few types of a large schema
+func GenerateTypes(graphSchema string, conf config.Config, only []string) (map[string]string, error) {
+	return NewCodeGen(graphSchema, conf).GenerateTypes(only, false)
+}
+
+// GenerateTypes generates the files of the named types, and with
+// dependencies the files of the types they refer to, transitively, by their
+// fields, arguments, input fields, interfaces and possible types. Names that
+// are not generated types, unknown or ignored, are returned as an error.
+func (g *CodeGen) GenerateTypes(only []string, dependencies bool) (map[string]string, error) {
+	results := map[string]string{}
+	err := g.generateFiles(func(types []*introspection.Type) (map[string]bool, error) {
+		return selectTypes(types, only, dependencies)
+	}, g.postProcess(func(fileName string, r io.Reader) error {
+		code, err := ioutil.ReadAll(r)
+		if err != nil {
+			return err
+		}
+		results[fileName] = string(code)
+		return nil
+	}))
+	if err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+// selectTypes returns the names of only, and with dependencies the types they
+// refer to, among types
+func selectTypes(types []*introspection.Type, only []string, dependencies bool) (map[string]bool, error) {
+	byName := make(map[string]*introspection.Type, len(types))
+	for _, tp := range types {
+		byName[*tp.Name()] = tp
+	}
+
+	var missing []string
+	selected := map[string]bool{}
+	for _, name := range only {
+		if byName[name] == nil {
+			missing = append(missing, name)
+			continue
+		}
+		selected[name] = true
+	}
+	if len(missing) > 0 {
+		return nil, fmt.Errorf("types %s are not generated from the schema", strings.Join(missing, ", "))
+	}
+	if !dependencies {
+		return selected, nil
+	}
+
+	queue := append([]string{}, only...)
+	for len(queue) > 0 {
+		tp := byName[queue[0]]
+		queue = queue[1:]
+		for _, name := range referencedTypes(tp) {
+			if byName[name] != nil && !selected[name] {
+				selected[name] = true
+				queue = append(queue, name)
+			}
+		}
+	}
+	return selected, nil
+}
+
+// referencedTypes returns the names of the types tp refers to by its fields
+// and their arguments, input fields, interfaces and possible types
+func referencedTypes(tp *introspection.Type) []string {
+	var refs []*introspection.Type
+	if tp.Fields(&struct{ IncludeDeprecated bool }{true}) != nil {
+		for _, fp := range *tp.Fields(&struct{ IncludeDeprecated bool }{true}) {
+			refs = append(refs, fp.Type())
+			for _, arg := range fp.Args() {
+				refs = append(refs, arg.Type())
+			}
+		}
+	}
+	if tp.InputFields() != nil {
+		for _, ip := range *tp.InputFields() {
+			refs = append(refs, ip.Type())
+		}
+	}
+	if tp.Interfaces() != nil {
+		refs = append(refs, *tp.Interfaces()...)
+	}
+	if tp.PossibleTypes() != nil {
+		refs = append(refs, *tp.PossibleTypes()...)
+	}
+
+	names := make([]string, 0, len(refs))
+	for _, ref := range refs {
+		for ref.Name() == nil {
+			ref = ref.OfType()
+		}
+		names = append(names, *ref.Name())
+	}
+	return names
+}
@@ -0,0 +1,68 @@
+package codegen
+
+import (
+	"io/ioutil"
+	"path"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/Applifier/graphql-codegen/config"
+)
+
+func TestGenerateTypes(t *testing.T) {
+	schema, err := ioutil.ReadFile(path.Join(fixtureDir, "starwars", "schema.graphql"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	conf := config.Config{Package: "starwars"}
+
+	all, err := NewCodeGen(string(schema), conf).Generate()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	fileMap, err := GenerateTypes(string(schema), conf, []string{"Review"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(fileMap) != 1 || fileMap["review_gen.go"] != all["review_gen.go"] {
+		t.Errorf("Expected only review_gen.go as generated by Generate, got %v", fileNames(fileMap))
+	}
+
+	fileMap, err = NewCodeGen(string(schema), conf).GenerateTypes([]string{"Starship", "FriendsEdge"}, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []string{"character_gen.go", "droid_gen.go", "episode_gen.go", "friendsconnection_gen.go", "friendsedge_gen.go", "human_gen.go", "lengthunit_gen.go", "pageinfo_gen.go", "starship_gen.go"}
+	if names := fileNames(fileMap); !reflect.DeepEqual(names, expected) {
+		t.Errorf("Expected the files of the types and their dependencies %v, got %v", expected, names)
+	}
+}
+
+func TestGenerateTypesMissing(t *testing.T) {
+	schema := `
+		type Query {
+			review: Review
+		}
+
+		type Review {
+			stars: Int!
+		}
+	`
+
+	_, err := GenerateTypes(schema, config.Config{}, []string{"Review", "Planet", "Moon"})
+	if err == nil || !strings.Contains(err.Error(), "Planet, Moon") {
+		t.Errorf("Expected an error listing the missing types, got %v", err)
+	}
+}
+
+func fileNames(fileMap map[string]string) []string {
+	names := make([]string, 0, len(fileMap))
+	for name := range fileMap {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,74 @@
+package codegen
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sync"
+	"text/template"
+
+	codegenTemplate "github.com/Applifier/graphql-codegen/template"
+)
+
+// templateCache holds the compiled templates keyed by template name and a hash
+// of the template text, so the same template text is not parsed again for
+// every type and field, while a changed template file is parsed anew
+var templateCache = struct {
+	sync.Mutex
+	templates map[string]*template.Template
+}{templates: map[string]*template.Template{}}
+
+// parseTemplate returns the template compiled from text, along with the
+// built-in partials it can call, parsing it only the first time the name and
+// text are seen. The cached template is cloned so the template funcs can be
+// bound to this CodeGen, once for each key as a template can be executed
+// concurrently.
+func (g *CodeGen) parseTemplate(name string, text string) (*template.Template, error) {
+	sum := sha256.Sum256([]byte(text))
+	key := name + "@" + hex.EncodeToString(sum[:])
+	if tmpl, ok := g.templates.Load(key); ok {
+		return tmpl.(*template.Template), nil
+	}
+
+	templateCache.Lock()
+	tmpl, ok := templateCache.templates[key]
+	if !ok {
+		partials, err := codegenTemplate.Partials()
+		if err == nil {
+			tmpl, err = template.New(name).Funcs(g.sharedFuncMap()).Parse(partials)
+		}
+		if err == nil {
+			tmpl, err = tmpl.Parse(text)
+		}
+		if err != nil {
+			templateCache.Unlock()
+			return nil, err
+		}
+		templateCache.templates[key] = tmpl
+	}
+	templateCache.Unlock()
+
+	clone, err := tmpl.Clone()
+	if err != nil {
+		return nil, err
+	}
+	bound, _ := g.templates.LoadOrStore(key, clone.Funcs(g.templateFuncMap()))
+	return bound.(*template.Template), nil
+}
+
+// sharedFuncMap returns the funcs the cached templates are parsed with, the
+// built-in funcs and a placeholder for each of conf.TemplateFuncs. The cached
+// templates are shared by every CodeGen, so the funcs of this one are only
+// bound to its clones.
+func (g *CodeGen) sharedFuncMap() template.FuncMap {
+	funcs := g.builtinFuncMap()
+	for name := range g.conf.TemplateFuncs {
+		if _, ok := funcs[name]; !ok {
+			name := name
+			funcs[name] = func(...interface{}) (interface{}, error) {
+				return nil, fmt.Errorf("template func %s is not set", name)
+			}
+		}
+	}
+	return funcs
+}
@@ -0,0 +1,5 @@
+package codegen
+
+// Version is the version of graphql-codegen, written into the header of the
+// generated files so files generated by another version can be told apart
+const Version = "1.0.0"
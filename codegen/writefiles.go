@@ -0,0 +1,170 @@
+package codegen
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+var (
+	// generatedMarker matches the header Go tooling uses to recognise generated files
+	generatedMarker = regexp.MustCompile(`(?m)^// Code generated .* DO NOT EDIT\.$`)
+	// legacyMarker is the header written by earlier versions of graphql-codegen
+	legacyMarker = []byte("// This code is genereated by graphql-codegen")
+)
+
+// WriteFiles writes the generated files returned by Generate to dir, creating
+// it if needed. Existing files are only overwritten when they were generated
+// too, so hand-written code sharing a name is never clobbered. Files without
+// the generated code marker, such as seeded resolvers, are written when they
+// do not exist, otherwise only the funcs missing from them are appended, e.g.
+// the stubs of fields added to the schema since.
+func WriteFiles(results map[string]string, dir string) error {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, fileName := range sortedFileNames(results) {
+		filePath := path.Join(dir, fileName)
+
+		code := results[fileName]
+		existing, err := ioutil.ReadFile(filePath)
+		if err == nil && !isGenerated([]byte(code)) {
+			var appended bool
+			code, appended, err = appendMissingFuncs(string(existing), code)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("%s: %v", filePath, err))
+				continue
+			}
+			if !appended {
+				continue
+			}
+		} else if err == nil && !isGenerated(existing) {
+			errs = append(errs, fmt.Errorf("%s exists and was not generated by graphql-codegen, refusing to overwrite", filePath))
+			continue
+		} else if err != nil && !os.IsNotExist(err) {
+			errs = append(errs, err)
+			continue
+		}
+
+		if err := os.MkdirAll(path.Dir(filePath), 0755); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+
+		if err := ioutil.WriteFile(filePath, []byte(code), 0644); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// appendMissingFuncs appends the funcs and methods of seed the hand-written
+// existing file does not declare, along with the imports of seed, and reports
+// whether there were any. The funcs already declared are left as they are.
+func appendMissingFuncs(existing string, seed string) (string, bool, error) {
+	fset := token.NewFileSet()
+	existingFile, err := parser.ParseFile(fset, "", existing, 0)
+	if err != nil {
+		return existing, false, fmt.Errorf("cannot add the new funcs, the file does not parse: %v", err)
+	}
+	seedFile, err := parser.ParseFile(fset, "", seed, parser.ParseComments)
+	if err != nil {
+		return existing, false, err
+	}
+
+	declared := map[string]bool{}
+	for _, decl := range existingFile.Decls {
+		if funcDecl, ok := decl.(*ast.FuncDecl); ok {
+			declared[funcKey(funcDecl)] = true
+		}
+	}
+
+	funcs := &bytes.Buffer{}
+	for _, decl := range seedFile.Decls {
+		funcDecl, ok := decl.(*ast.FuncDecl)
+		if !ok || declared[funcKey(funcDecl)] {
+			continue
+		}
+		start := funcDecl.Pos()
+		if funcDecl.Doc != nil {
+			start = funcDecl.Doc.Pos()
+		}
+		fmt.Fprintf(funcs, "\n%s\n", seed[fset.Position(start).Offset:fset.Position(funcDecl.End()).Offset])
+	}
+	if funcs.Len() == 0 {
+		return existing, false, nil
+	}
+
+	// The imports of seed are added after the package clause, formatting
+	// merges them with the imports of the file and leaves out the unused ones
+	imports := &bytes.Buffer{}
+	for _, spec := range seedFile.Imports {
+		fmt.Fprintf(imports, "\t%s\n", importLine(spec))
+	}
+	code := existing
+	if imports.Len() > 0 {
+		end := fset.Position(existingFile.Name.End()).Offset
+		code = existing[:end] + "\n\nimport (\n" + imports.String() + ")\n" + existing[end:]
+	}
+	formatted, err := formatGenerated(strings.TrimRight(code, "\n") + "\n" + funcs.String())
+	if err != nil {
+		return existing, false, err
+	}
+	return string(formatted), true, nil
+}
+
+// funcKey tells the funcs of a file apart, methods by their receiver type
+func funcKey(decl *ast.FuncDecl) string {
+	if decl.Recv == nil || len(decl.Recv.List) == 0 {
+		return decl.Name.Name
+	}
+	recv := decl.Recv.List[0].Type
+	if star, ok := recv.(*ast.StarExpr); ok {
+		recv = star.X
+	}
+	if ident, ok := recv.(*ast.Ident); ok {
+		return ident.Name + "." + decl.Name.Name
+	}
+	return decl.Name.Name
+}
+
+// WriteTo writes the generated files returned by Generate to w one after the
+// other in file name order, each preceded by a comment naming the file
+func WriteTo(w io.Writer, results map[string]string) error {
+	for i, fileName := range sortedFileNames(results) {
+		separator := "\n"
+		if i == 0 {
+			separator = ""
+		}
+		if _, err := fmt.Fprintf(w, "%s// %s\n%s", separator, fileName, results[fileName]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func sortedFileNames(results map[string]string) []string {
+	fileNames := make([]string, 0, len(results))
+	for fileName := range results {
+		fileNames = append(fileNames, fileName)
+	}
+	sort.Strings(fileNames)
+	return fileNames
+}
+
+func isGenerated(code []byte) bool {
+	return generatedMarker.Match(code) || bytes.HasPrefix(code, legacyMarker)
+}
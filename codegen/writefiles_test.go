@@ -0,0 +1,154 @@
+package codegen
+
+import (
+	"io/ioutil"
+	"path"
+	"strings"
+	"testing"
+
+	"github.com/Applifier/graphql-codegen/config"
+)
+
+func TestWriteFiles(t *testing.T) {
+	dir := path.Join(t.TempDir(), "generated")
+
+	generated := "// Code generated by graphql-codegen. DO NOT EDIT.\n\npackage main\n"
+	if err := WriteFiles(map[string]string{"user_gen.go": generated}, dir); err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := ioutil.ReadFile(path.Join(dir, "user_gen.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != generated {
+		t.Errorf("Written file content\n%s\n\nshould have matched\n\n%s", data, generated)
+	}
+
+	// Types in their own package are written into subdirectories
+	if err := WriteFiles(map[string]string{"models/user_gen.go": generated}, dir); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ioutil.ReadFile(path.Join(dir, "models", "user_gen.go")); err != nil {
+		t.Error(err)
+	}
+
+	// Regenerating over a generated file is allowed
+	if err := WriteFiles(map[string]string{"user_gen.go": generated}, dir); err != nil {
+		t.Fatal(err)
+	}
+
+	handWritten := "package main\n\nfunc main() {}\n"
+	if err := ioutil.WriteFile(path.Join(dir, "main_gen.go"), []byte(handWritten), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	err = WriteFiles(map[string]string{"main_gen.go": generated}, dir)
+	if err == nil || !strings.Contains(err.Error(), "refusing to overwrite") {
+		t.Fatalf("Expected overwrite of a hand-written file to fail, got %v", err)
+	}
+
+	data, _ = ioutil.ReadFile(path.Join(dir, "main_gen.go"))
+	if string(data) != handWritten {
+		t.Error("Hand-written file should not have been modified")
+	}
+}
+
+func TestWriteFilesSeed(t *testing.T) {
+	dir := t.TempDir()
+
+	seed := "// Resolvers of Query, created by graphql-codegen and not overwritten afterwards\n\npackage main\n"
+	if err := WriteFiles(map[string]string{"query_resolvers.go": seed}, dir); err != nil {
+		t.Fatal(err)
+	}
+	data, err := ioutil.ReadFile(path.Join(dir, "query_resolvers.go"))
+	if err != nil || string(data) != seed {
+		t.Fatalf("Expected the seed to be written, got %q, %v", data, err)
+	}
+
+	edited := seed + "\nfunc (r *Resolver) Hello() string { return \"hello\" }\n"
+	if err := ioutil.WriteFile(path.Join(dir, "query_resolvers.go"), []byte(edited), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := WriteFiles(map[string]string{"query_resolvers.go": seed}, dir); err != nil {
+		t.Fatal(err)
+	}
+	data, _ = ioutil.ReadFile(path.Join(dir, "query_resolvers.go"))
+	if string(data) != edited {
+		t.Error("An existing seed should not be overwritten")
+	}
+}
+
+func TestWriteFilesSeedNewFields(t *testing.T) {
+	dir := t.TempDir()
+	conf := config.Config{SeedResolvers: true, Type: map[string]config.TypeConfig{"Query": {WithContext: true}}}
+	schema := `
+		type Query {
+			hello: String!
+		}
+	`
+	fileMap, err := NewCodeGen(schema, conf).Generate()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := WriteFiles(fileMap, dir); err != nil {
+		t.Fatal(err)
+	}
+	data, err := ioutil.ReadFile(path.Join(dir, "query_resolvers.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	implemented := strings.Replace(string(data), "panic(\"not implemented\")", "return \"hello\"", 1)
+	if err := ioutil.WriteFile(path.Join(dir, "query_resolvers.go"), []byte(implemented), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	// A field added to the schema gets a stub, the implemented ones are kept
+	schema = strings.Replace(schema, "hello: String!", "hello: String!\n\t\t\tcount(first: Int): Int!", 1)
+	fileMap, err = NewCodeGen(schema, conf).Generate()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := WriteFiles(fileMap, dir); err != nil {
+		t.Fatal(err)
+	}
+	data, _ = ioutil.ReadFile(path.Join(dir, "query_resolvers.go"))
+	for _, expected := range []string{
+		"import (\n\t\"context\"\n)\n",
+		"func (r *Resolver) Hello(ctx context.Context) string {\n\treturn \"hello\"\n}\n",
+		"func (r *Resolver) Count(ctx context.Context, args *QueryCountArgs) int32 {\n\tpanic(\"not implemented\")\n}\n",
+	} {
+		if !strings.Contains(string(data), expected) {
+			t.Errorf("Expected %q in the seeded file\n%s", expected, data)
+		}
+	}
+	if strings.Count(string(data), "func (r *Resolver) Hello(") != 1 {
+		t.Errorf("Expected Hello to be declared once\n%s", data)
+	}
+
+	// Nothing is appended when the file declares every resolver
+	if err := WriteFiles(fileMap, dir); err != nil {
+		t.Fatal(err)
+	}
+	again, _ := ioutil.ReadFile(path.Join(dir, "query_resolvers.go"))
+	if string(again) != string(data) {
+		t.Errorf("Expected the seeded file to be left as it is\n%s", again)
+	}
+}
+
+func TestWriteTo(t *testing.T) {
+	results := map[string]string{
+		"user_gen.go":     "package main\n\ntype User struct{}\n",
+		"resolver_gen.go": "package main\n\ntype Resolver struct{}\n",
+	}
+
+	buf := &strings.Builder{}
+	if err := WriteTo(buf, results); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := "// resolver_gen.go\npackage main\n\ntype Resolver struct{}\n\n// user_gen.go\npackage main\n\ntype User struct{}\n"
+	if buf.String() != expected {
+		t.Errorf("Written output\n%s\n\nshould have matched\n\n%s", buf.String(), expected)
+	}
+}
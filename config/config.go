@@ -1,17 +1,185 @@
 package config
 
+import (
+	"text/template"
+)
+
 type FieldConfig struct {
 	Template map[string]map[string]interface{}
 	Imports  []string
+	// WithContext adds a context.Context as the first resolver method parameter
+	WithContext bool `hcl:"with_context" yaml:"with_context" json:"with_context"`
+	// WithError makes the resolver method return an error alongside the value
+	WithError bool `hcl:"with_error" yaml:"with_error" json:"with_error"`
+	// GoName is the Go identifier of the struct field and resolver method (default the capitalised field name, or camel cased with camel_case)
+	GoName string `hcl:"go_name" yaml:"go_name" json:"go_name"`
+	// NullStrategy is the struct field type of a nullable scalar: pointer (default), zero or sqlNull
+	NullStrategy string `hcl:"null_strategy" yaml:"null_strategy" json:"null_strategy"`
+	// GoType replaces the Go type of the field computed from its schema type, e.g. *url.URL
+	GoType string `hcl:"go_type" yaml:"go_type" json:"go_type"`
+	// ImportPath is the import path of the package of GoType
+	ImportPath string `hcl:"import_path" yaml:"import_path" json:"import_path"`
+	// Dataloader generates a loader batching the loads of a list field of an object type, keyed by the
+	// id of the object, with a batch function seeded into <type>_loaders.go
+	Dataloader bool `hcl:"dataloader" yaml:"dataloader" json:"dataloader"`
+	// Memoize caches the results of the body template resolver method on the resolver, keyed by the arguments
+	Memoize bool `hcl:"memoize" yaml:"memoize" json:"memoize"`
 }
 
 type TypeConfig struct {
 	Template map[string]map[string]interface{}
 	Field    map[string]FieldConfig
 	Imports  []string
+	// IncludeDeprecated keeps deprecated enum values in the generated constants
+	IncludeDeprecated bool `hcl:"include_deprecated" yaml:"include_deprecated" json:"include_deprecated"`
+	// SkipDeprecated leaves out deprecated fields, their resolvers then have to be written by hand
+	SkipDeprecated bool `hcl:"skip_deprecated" yaml:"skip_deprecated" json:"skip_deprecated"`
+	// Validate generates a Validate method on an input object checking its enum fields hold known values
+	Validate bool
+	// UnwrapInput makes the resolvers of the entry point fields taking a single non-null input object
+	// argument take the input object directly instead of an arguments struct holding a pointer to it
+	UnwrapInput bool `hcl:"unwrap_input" yaml:"unwrap_input" json:"unwrap_input"`
+	// StringField is the scalar or enum field of an object type its resolver prints in a
+	// generated String method, e.g. name
+	StringField string `hcl:"string_field" yaml:"string_field" json:"string_field"`
+	// Labels maps the values of an enum type to display names, generating a Label method
+	// and a Parse<Type>Label func. Values without a label are labeled with their name.
+	Labels map[string]string
+	// SQL generates the Value and Scan methods storing an enum type in a database/sql
+	// column, scanning only known values
+	SQL bool
+	// WithContext adds a context.Context parameter to every resolver method of the type
+	WithContext bool `hcl:"with_context" yaml:"with_context" json:"with_context"`
+	// WithError makes every resolver method of the type return an error alongside the value
+	WithError bool `hcl:"with_error" yaml:"with_error" json:"with_error"`
+	// GoName is the Go identifier of the type, replacing the schema name in the generated code
+	GoName string `hcl:"go_name" yaml:"go_name" json:"go_name"`
+	// Package generates the type into its own package instead of the config package
+	Package string
+	// Dir is the output directory of the type relative to the output directory (default Package)
+	Dir string
+}
+
+// ScalarConfig maps a custom schema scalar to an existing Go type
+type ScalarConfig struct {
+	GoType     string `hcl:"go_type" yaml:"go_type" json:"go_type"`
+	ImportPath string `hcl:"import_path" yaml:"import_path" json:"import_path"`
+	// Alias is the name the package is imported as, replacing the package name in GoType
+	Alias string
+	// Assert adds a compile time assertion to resolver_gen.go that GoType implements the
+	// ImplementsGraphQLType and UnmarshalGraphQL methods graphql-go decodes custom scalars with
+	Assert bool
 }
 
 type Config struct {
 	Package string
 	Type    map[string]TypeConfig
+	Scalar  map[string]ScalarConfig
+	// ImportPath is the import path of the output directory, needed to import
+	// types generated into another package
+	ImportPath string `hcl:"import_path" yaml:"import_path" json:"import_path"`
+	// KeywordSuffix is appended to lowercased identifiers that are Go keywords (default "_")
+	KeywordSuffix string `hcl:"keyword_suffix" yaml:"keyword_suffix" json:"keyword_suffix"`
+	// ResolverSuffix is appended to type names to name their resolvers (default "Resolver").
+	// An empty suffix needs templates that do not also declare a struct named after the type.
+	ResolverSuffix *string `hcl:"resolver_suffix" yaml:"resolver_suffix" json:"resolver_suffix"`
+	// JSONTags adds a json tag with the schema name to the struct fields (default true)
+	JSONTags *bool `hcl:"json_tags" yaml:"json_tags" json:"json_tags"`
+	// OmitEmpty adds omitempty to the json tags of nullable fields (nullable, the default),
+	// of all fields (always) or of none (never)
+	OmitEmpty string `hcl:"omit_empty" yaml:"omit_empty" json:"omit_empty"`
+	// TemplateDir is a directory of templates laid out like the built-in ones,
+	// type/<name> and property/<name>. Its templates take precedence over the
+	// built-in templates with the same name.
+	TemplateDir string `hcl:"template_dir" yaml:"template_dir" json:"template_dir"`
+	// TemplateFuncs are added to the funcs of the templates, e.g. for naming helpers
+	// used by the templates of TemplateDir. It is only set from code, the names of the
+	// built-in funcs cannot be used.
+	TemplateFuncs template.FuncMap `json:"-" yaml:"-"`
+	// Mode is server (default) to generate resolvers, client to generate
+	// the request variables and response data structs of the entry points
+	// or models to generate plain structs of the types without the entry points
+	Mode string
+	// Target is gqlgen to generate models for binding in a gqlgen project:
+	// the models mode with IDs as strings, interfaces and unions as Go
+	// interfaces with an Is<Name> marker method and nullable lists as slices
+	Target string
+	// IncludeMetaTypes generates the introspection types, __Schema, __Type and
+	// the others, named without the leading underscores, e.g. Type for __Type
+	IncludeMetaTypes bool `hcl:"include_meta_types" yaml:"include_meta_types" json:"include_meta_types"`
+	// Ignore skips the types whose name matches one of the patterns, which use
+	// the path.Match syntax, e.g. "*Payload"
+	Ignore []string
+	// FileNaming names the generated files: lower (default) for usertype_gen.go,
+	// snake for user_type_gen.go, or a template executed with the Go type
+	// .Name, its .Kind and the .Suffix (_gen.go or _mock_gen.go) of the file,
+	// e.g. "{{lower .Kind}}_{{snake .Name}}{{.Suffix}}"
+	FileNaming string `hcl:"file_naming" yaml:"file_naming" json:"file_naming"`
+	// TypePrefix is prepended to the Go names of the generated types without a
+	// go_name, including Resolver and the Schema constant, e.g. to generate
+	// several schemas into one package
+	TypePrefix string `hcl:"type_prefix" yaml:"type_prefix" json:"type_prefix"`
+	// CamelCase names the struct fields and resolver methods of snake_case and
+	// kebab-case fields in camel case, e.g. UserID for user_id, instead of
+	// capitalising the schema name
+	CamelCase bool `hcl:"camel_case" yaml:"camel_case" json:"camel_case"`
+	// GenerateSchema generates a Schema constant holding the schema into schema_gen.go
+	GenerateSchema bool `hcl:"generate_schema" yaml:"generate_schema" json:"generate_schema"`
+	// GenerateRegistry adds a NewResolver func returning the resolver of the schema
+	// roots to resolver_gen.go, listing the resolver types of the other types
+	GenerateRegistry bool `hcl:"generate_registry" yaml:"generate_registry" json:"generate_registry"`
+	// RootInterfaces generates the query, mutation and subscription types as QueryResolver,
+	// MutationResolver and SubscriptionResolver interfaces, and a RootResolver struct
+	// embedding them to parse the schema with, instead of the methods of Resolver
+	RootInterfaces bool `hcl:"root_interfaces" yaml:"root_interfaces" json:"root_interfaces"`
+	// SeedResolvers moves the resolvers of the query and mutation types into a
+	// <type>_resolvers.go file, which is written once and then edited by hand
+	SeedResolvers bool `hcl:"seed_resolvers" yaml:"seed_resolvers" json:"seed_resolvers"`
+	// Mocks generates a mock of every interface type into a <type>_mock_gen.go file
+	Mocks bool
+	// AssertInterfaces adds a compile time assertion that the resolver of an object type
+	// implements the interfaces of the type
+	AssertInterfaces bool `hcl:"assert_interfaces" yaml:"assert_interfaces" json:"assert_interfaces"`
+	// EmbedStruct is a struct type embedded as a pointer into the Resolver and the
+	// resolvers of the object types, e.g. Deps or deps.Deps holding shared dependencies
+	EmbedStruct string `hcl:"embed_struct" yaml:"embed_struct" json:"embed_struct"`
+	// EmbedImportPath is the import path of the package of EmbedStruct
+	EmbedImportPath string `hcl:"embed_import_path" yaml:"embed_import_path" json:"embed_import_path"`
+	// AnnotateSource adds the schema type or field a generated type or method
+	// comes from to its doc comment, e.g. // source: Human.friends
+	AnnotateSource bool `hcl:"annotate_source" yaml:"annotate_source" json:"annotate_source"`
+	// StrictUnsupported makes generation fail on types that would be generated as a placeholder
+	// to implement by hand, such as custom scalars without a scalar config
+	StrictUnsupported bool `hcl:"strict_unsupported" yaml:"strict_unsupported" json:"strict_unsupported"`
+	// Workers is the number of types generated concurrently (default GOMAXPROCS)
+	Workers int
+	// Logger receives the progress messages of the generation (default log.Printf).
+	// It is only set from code and is called concurrently when Workers > 1, a
+	// func that does nothing silences the messages.
+	Logger func(format string, args ...interface{}) `json:"-" yaml:"-"`
+	// PostProcess is called with every generated file after it is formatted, returning
+	// the code to use instead, e.g. to run goimports or add a license header. It is only
+	// set from code, an error stops the generation.
+	PostProcess func(fileName string, code []byte) ([]byte, error) `json:"-" yaml:"-"`
+	// Namer names the generated types, fields, files and resolvers instead of
+	// type_prefix, camel_case, resolver_suffix and file_naming. It is only set
+	// from code, go_name still takes precedence.
+	Namer Namer `json:"-" yaml:"-"`
+}
+
+// Namer names the Go code generated for the schema, see codegen.DefaultNamer
+// for the naming of the config
+type Namer interface {
+	// TypeName returns the Go name of a schema type, also used for the
+	// Resolver, RootResolver and Schema declarations
+	TypeName(gqlName string) string
+	// FieldName returns the Go name of a field or argument
+	FieldName(gqlName string) string
+	// FileName returns the name of the file generated for the Go name of a
+	// type. Kind is its introspection kind, RESOLVER or SCHEMA, and suffix tells
+	// the files of a type apart, e.g. _gen.go or _mock_gen.go.
+	FileName(goName string, kind string, suffix string) (string, error)
+	// ResolverName returns the name of the resolver type of the Go name of a
+	// type
+	ResolverName(goName string) string
 }
@@ -0,0 +1,206 @@
+package config
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// goTypeDirective is the directive mapping a custom scalar to a Go type in the
+// schema, e.g. scalar UUID @goType(name: "uuid.UUID", import: "github.com/google/uuid")
+const goTypeDirective = "@goType"
+
+// GoTypeDirectives returns the scalar configs given by @goType directives and
+// src without them, which the schema is parsed from. The schema parser does not report applied directives, so
+// they are read from the SDL. The newlines of a removed directive are kept to
+// keep the line numbers of the errors reported by the parser.
+func GoTypeDirectives(src string) (string, map[string]ScalarConfig, error) {
+	scalars := map[string]ScalarConfig{}
+	out := &strings.Builder{}
+	depth := 0
+	last := 0
+
+	for i := 0; i < len(src); i++ {
+		c := src[i]
+		switch {
+		case c == '#':
+			for i < len(src) && src[i] != '\n' {
+				i++
+			}
+		case c == '"':
+			i = skipString(src, i)
+		case c == '{':
+			depth++
+		case c == '}':
+			depth--
+		case depth == 0 && isNameStart(c):
+			start := i
+			for i+1 < len(src) && isNameChar(src[i+1]) {
+				i++
+			}
+			if src[start:i+1] != "scalar" {
+				continue
+			}
+
+			name, nameEnd := nextName(src, i+1)
+			at := goTypeOffset(src, nameEnd)
+			if name == "" || at < 0 {
+				continue
+			}
+
+			scalar, end, err := parseGoTypeDirective(src, at+len(goTypeDirective))
+			if err != nil {
+				return "", nil, fmt.Errorf("invalid %s directive on scalar %s: %v", goTypeDirective, name, err)
+			}
+			scalars[name] = scalar
+
+			out.WriteString(strings.TrimRight(src[last:at], " \t"))
+			out.WriteString(strings.Repeat("\n", strings.Count(src[at:end], "\n")))
+			last = end
+			i = end - 1
+		}
+	}
+
+	out.WriteString(src[last:])
+	return out.String(), scalars, nil
+}
+
+// goTypeOffset returns the offset of the @goType directive among the
+// directives of a scalar starting at i, or -1 when it has none. The other
+// directives, like @specifiedBy, are skipped along with their arguments.
+func goTypeOffset(src string, i int) int {
+	for i = skipSpace(src, i); i < len(src) && src[i] == '@'; i = skipSpace(src, i) {
+		name, end := nextName(src, i+1)
+		if "@"+name == goTypeDirective {
+			return i
+		}
+		if name == "" {
+			return -1
+		}
+		i = skipSpace(src, end)
+		if i < len(src) && src[i] == '(' {
+			i = skipArguments(src, i)
+		}
+	}
+	return -1
+}
+
+// skipArguments returns the offset after the parenthesis closing the
+// arguments starting at i
+func skipArguments(src string, i int) int {
+	depth := 0
+	for ; i < len(src); i++ {
+		switch src[i] {
+		case '"':
+			i = skipString(src, i)
+		case '(':
+			depth++
+		case ')':
+			depth--
+			if depth == 0 {
+				return i + 1
+			}
+		}
+	}
+	return i
+}
+
+// parseGoTypeDirective parses the arguments of a @goType directive starting
+// at i and returns the offset after the closing parenthesis
+func parseGoTypeDirective(src string, i int) (ScalarConfig, int, error) {
+	var scalar ScalarConfig
+	i = skipSpace(src, i)
+	if i >= len(src) || src[i] != '(' {
+		return scalar, 0, fmt.Errorf("missing arguments")
+	}
+
+	for i = skipSpace(src, i+1); i < len(src) && src[i] != ')'; i = skipSpace(src, i) {
+		arg, argEnd := nextName(src, i)
+		if arg == "" {
+			return scalar, 0, fmt.Errorf("expected an argument name at %q", src[i:i+1])
+		}
+		i = skipSpace(src, argEnd)
+		if i >= len(src) || src[i] != ':' {
+			return scalar, 0, fmt.Errorf("missing value of %s", arg)
+		}
+		i = skipSpace(src, i+1)
+		if i >= len(src) || src[i] != '"' {
+			return scalar, 0, fmt.Errorf("the value of %s must be a string", arg)
+		}
+		end := skipString(src, i) + 1
+		if end > len(src) {
+			return scalar, 0, fmt.Errorf("unterminated value of %s", arg)
+		}
+		value, err := strconv.Unquote(src[i:end])
+		if err != nil {
+			return scalar, 0, fmt.Errorf("invalid value of %s: %v", arg, err)
+		}
+		i = end
+
+		switch arg {
+		case "name":
+			scalar.GoType = value
+		case "import":
+			scalar.ImportPath = value
+		case "alias":
+			scalar.Alias = value
+		default:
+			return scalar, 0, fmt.Errorf("unknown argument %s, expected name, import or alias", arg)
+		}
+	}
+	if i >= len(src) {
+		return scalar, 0, fmt.Errorf("missing )")
+	}
+	if scalar.GoType == "" {
+		return scalar, 0, fmt.Errorf("missing name")
+	}
+	return scalar, i + 1, nil
+}
+
+// nextName returns the name starting at the first non space character from i
+// and the offset after it
+func nextName(src string, i int) (string, int) {
+	i = skipSpace(src, i)
+	if i >= len(src) || !isNameStart(src[i]) {
+		return "", i
+	}
+	start := i
+	for i < len(src) && isNameChar(src[i]) {
+		i++
+	}
+	return src[start:i], i
+}
+
+// skipSpace returns the offset of the first character from i that is not
+// white space or a comma, which GraphQL ignores like white space
+func skipSpace(src string, i int) int {
+	for i < len(src) && strings.IndexByte(" \t\r\n,", src[i]) >= 0 {
+		i++
+	}
+	return i
+}
+
+// skipString returns the offset of the closing quote of the string or block
+// string starting at i
+func skipString(src string, i int) int {
+	if strings.HasPrefix(src[i:], `"""`) {
+		if end := strings.Index(src[i+3:], `"""`); end >= 0 {
+			return i + 3 + end + 2
+		}
+		return len(src)
+	}
+	for i++; i < len(src) && src[i] != '"' && src[i] != '\n'; i++ {
+		if src[i] == '\\' {
+			i++
+		}
+	}
+	return i
+}
+
+func isNameStart(c byte) bool {
+	return c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
+func isNameChar(c byte) bool {
+	return isNameStart(c) || (c >= '0' && c <= '9')
+}
@@ -0,0 +1,59 @@
+package config
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestGoTypeDirectives(t *testing.T) {
+	src := `# scalar Commented @goType(name: "ignored")
+scalar UUID @goType(name: "uuid.UUID", import: "github.com/google/uuid")
+scalar Time @goType(
+	name: "time.Time"
+	import: "time"
+)
+scalar JSON
+scalar URL @specifiedBy(url: "https://tools.ietf.org/html/rfc3986 (URI)") @goType(name: "url.URL", import: "net/url")
+scalar Email @deprecated @goTypes(name: "ignored")
+
+type Query {
+	id: UUID!
+}
+`
+
+	schema, scalars, err := GoTypeDirectives(src)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := map[string]ScalarConfig{
+		"UUID": {GoType: "uuid.UUID", ImportPath: "github.com/google/uuid"},
+		"Time": {GoType: "time.Time", ImportPath: "time"},
+		"URL":  {GoType: "url.URL", ImportPath: "net/url"},
+	}
+	if !reflect.DeepEqual(scalars, expected) {
+		t.Errorf("Unexpected scalars %v", scalars)
+	}
+	if strings.Count(schema, "@goType(") != 1 || !strings.Contains(schema, "scalar URL @specifiedBy(") {
+		t.Errorf("The @goType directives should be removed outside comments, the others kept\n%s", schema)
+	}
+	if strings.Count(schema, "\n") != strings.Count(src, "\n") {
+		t.Errorf("Removing the directives should keep the lines\n%s", schema)
+	}
+}
+
+func TestGoTypeDirectiveErrors(t *testing.T) {
+	for _, src := range []string{
+		`scalar UUID @goType`,
+		`scalar UUID @goType(import: "github.com/google/uuid")`,
+		`scalar UUID @goType(name: uuid)`,
+		`scalar UUID @goType(name: "uuid.UUID", package: "uuid")`,
+		`scalar UUID @goType(name: "uuid.UUID"`,
+		`scalar UUID @goType(name: "uuid.UUID`,
+	} {
+		if _, _, err := GoTypeDirectives(src); err == nil {
+			t.Errorf("Expected an error for %s", src)
+		}
+	}
+}
@@ -0,0 +1,62 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// DiscoveryFiles are the config file names Discover looks for, in every
+// directory
+var DiscoveryFiles = []string{"codegen.hcl", "codegen.yaml", "codegen.yml", "codegen.json"}
+
+// Discover loads the config file found by DiscoverFile from dir. Without a
+// config file the default config is returned.
+func Discover(dir string) (Config, error) {
+	path, err := DiscoverFile(dir)
+	if err != nil || path == "" {
+		return Config{}, err
+	}
+	conf, err := Load(path)
+	if err != nil {
+		return Config{}, fmt.Errorf("loading config %s: %v", path, err)
+	}
+	return conf, nil
+}
+
+// DiscoverFile returns the path of the config file in dir or the closest of
+// its parent directories, stopping at the module root holding go.mod. An
+// empty path is returned when there is no config file, and an error when a
+// directory has more than one.
+func DiscoverFile(dir string) (string, error) {
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return "", err
+	}
+
+	for {
+		var found []string
+		for _, name := range DiscoveryFiles {
+			path := filepath.Join(dir, name)
+			if info, err := os.Stat(path); err == nil && !info.IsDir() {
+				found = append(found, path)
+			}
+		}
+		if len(found) > 1 {
+			return "", fmt.Errorf("more than one config file: %s", strings.Join(found, ", "))
+		}
+		if len(found) == 1 {
+			return found[0], nil
+		}
+
+		if _, err := os.Stat(filepath.Join(dir, "go.mod")); err == nil {
+			return "", nil
+		}
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return "", nil
+		}
+		dir = parent
+	}
+}
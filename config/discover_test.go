@@ -0,0 +1,69 @@
+package config
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func writeFile(t *testing.T, path string, content string) {
+	t.Helper()
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestDiscover(t *testing.T) {
+	root := t.TempDir()
+	// root/codegen.yaml is outside of the module and never found
+	writeFile(t, filepath.Join(root, "codegen.yaml"), "package: outside\n")
+	writeFile(t, filepath.Join(root, "module", "go.mod"), "module example.com/module\n")
+	writeFile(t, filepath.Join(root, "module", "api", "codegen.json"), `{"package": "api"}`)
+	writeFile(t, filepath.Join(root, "module", "api", "v1", "graph", "schema.graphql"), "")
+	writeFile(t, filepath.Join(root, "module", "web", "schema.graphql"), "")
+
+	tests := []struct {
+		dir      string
+		expected string
+	}{
+		{"module/api", "api"},
+		{"module/api/v1/graph", "api"},
+		{"module/web", ""},
+		{"module", ""},
+		{".", "outside"},
+	}
+	for _, test := range tests {
+		conf, err := Discover(filepath.Join(root, test.dir))
+		if err != nil {
+			t.Errorf("Discover(%s): %v", test.dir, err)
+			continue
+		}
+		if conf.Package != test.expected {
+			t.Errorf("Discover(%s) found package %q, expected %q", test.dir, conf.Package, test.expected)
+		}
+	}
+
+	path, err := DiscoverFile(filepath.Join(root, "module", "api", "v1"))
+	if err != nil || path != filepath.Join(root, "module", "api", "codegen.json") {
+		t.Errorf("DiscoverFile found %q, %v", path, err)
+	}
+
+	writeFile(t, filepath.Join(root, "module", "api", "codegen.hcl"), `package = "api"`)
+	if _, err := Discover(filepath.Join(root, "module", "api", "v1")); err == nil || !strings.Contains(err.Error(), "more than one config file") {
+		t.Errorf("Expected an error for two config files in one directory, got %v", err)
+	}
+}
+
+func TestDiscoverInvalid(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, filepath.Join(dir, "go.mod"), "module example.com/module\n")
+	writeFile(t, filepath.Join(dir, "codegen.json"), `{"packages": "api"}`)
+	if _, err := Discover(dir); err == nil || !strings.Contains(err.Error(), "codegen.json") {
+		t.Errorf("Expected the invalid config file to be named in the error, got %v", err)
+	}
+}
@@ -0,0 +1,32 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+)
+
+// LoadJSON loads codegen config from a JSON file
+func LoadJSON(path string) (Config, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return Config{}, err
+	}
+	defer file.Close()
+
+	return LoadJSONReader(file)
+}
+
+// LoadJSONReader reads codegen config in JSON format. Unknown keys are
+// reported as errors
+func LoadJSONReader(r io.Reader) (cfg Config, err error) {
+	decoder := json.NewDecoder(r)
+	decoder.UseNumber()
+	decoder.DisallowUnknownFields()
+	if err = decoder.Decode(&cfg); err != nil {
+		return cfg, fmt.Errorf("invalid JSON config: %v", err)
+	}
+	normaliseTemplates(&cfg)
+	return
+}
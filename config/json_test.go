@@ -0,0 +1,95 @@
+package config
+
+import (
+	"io/ioutil"
+	"path"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const jsonConfig = `{
+  "package": "example",
+  "scalar": {
+    "DateTime": {"go_type": "time.Time", "import_path": "time"}
+  },
+  "type": {
+    "User": {
+      "with_error": true,
+      "field": {
+        "friends": {
+          "dataloader": true,
+          "memoize": true,
+          "template": {
+            "http_resolver": {
+              "url": "\"https://example.com/friends\"",
+              "limit": 10,
+              "ratio": 0.5,
+              "fields": [{"field_name": "friend_ids", "size": 3}]
+            }
+          }
+        }
+      }
+    }
+  }
+}`
+
+func TestLoadJSON(t *testing.T) {
+	file := path.Join(t.TempDir(), "config.json")
+	if err := ioutil.WriteFile(file, []byte(jsonConfig), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	cfg, err := LoadJSON(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := Config{
+		Package: "example",
+		Scalar: map[string]ScalarConfig{
+			"DateTime": ScalarConfig{
+				GoType:     "time.Time",
+				ImportPath: "time",
+			},
+		},
+		Type: map[string]TypeConfig{
+			"User": TypeConfig{
+				WithError: true,
+				Field: map[string]FieldConfig{
+					"friends": FieldConfig{
+						Dataloader: true,
+						Memoize:    true,
+						Template: map[string]map[string]interface{}{
+							"http_resolver": map[string]interface{}{
+								"url":   "\"https://example.com/friends\"",
+								"limit": 10,
+								"ratio": 0.5,
+								"fields": []interface{}{
+									map[string]interface{}{
+										"field_name": "friend_ids",
+										"size":       3,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	if !reflect.DeepEqual(cfg, expected) {
+		t.Errorf("Expected\n%+v\nto equal\n%+v", cfg, expected)
+	}
+}
+
+func TestLoadJSONUnknownKey(t *testing.T) {
+	_, err := LoadJSONReader(strings.NewReader(`{"package": "main", "pakage": "main"}`))
+	if err == nil {
+		t.Fatal("Expected an error for an unknown key")
+	}
+	if !strings.Contains(err.Error(), `"pakage"`) {
+		t.Errorf("Error %q should name the unknown key", err)
+	}
+}
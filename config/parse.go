@@ -1,9 +1,78 @@
 package config
 
-import "github.com/hashicorp/hcl"
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+
+	"github.com/hashicorp/hcl"
+)
 
 // Parse parses codegen config
 func Parse(config string) (cfg Config, err error) {
 	err = hcl.Decode(&cfg, config)
 	return
 }
+
+// Load loads codegen config from a file in the format matching its
+// extension, YAML for .yaml and .yml, JSON for .json and HCL otherwise
+func Load(path string) (Config, error) {
+	switch filepath.Ext(path) {
+	case ".yaml", ".yml":
+		return LoadYAML(path)
+	case ".json":
+		return LoadJSON(path)
+	}
+
+	fileBytes, err := ioutil.ReadFile(path)
+	if err != nil {
+		return Config{}, err
+	}
+	return Parse(string(fileBytes))
+}
+
+func normaliseTemplates(cfg *Config) {
+	for _, typeConf := range cfg.Type {
+		normaliseTemplate(typeConf.Template)
+		for _, fieldConf := range typeConf.Field {
+			normaliseTemplate(fieldConf.Template)
+		}
+	}
+}
+
+// normaliseTemplate converts decoder specific template values into the types
+// used by the HCL decoder: string keyed maps, and integers instead of json.Number
+func normaliseTemplate(tmpl map[string]map[string]interface{}) {
+	for _, values := range tmpl {
+		for key, value := range values {
+			values[key] = normaliseValue(value)
+		}
+	}
+}
+
+func normaliseValue(value interface{}) interface{} {
+	switch val := value.(type) {
+	case map[interface{}]interface{}:
+		m := make(map[string]interface{}, len(val))
+		for key, v := range val {
+			m[fmt.Sprint(key)] = normaliseValue(v)
+		}
+		return m
+	case map[string]interface{}:
+		for key, v := range val {
+			val[key] = normaliseValue(v)
+		}
+	case []interface{}:
+		for i, v := range val {
+			val[i] = normaliseValue(v)
+		}
+	case json.Number:
+		if i, err := val.Int64(); err == nil {
+			return int(i)
+		}
+		f, _ := val.Float64()
+		return f
+	}
+	return value
+}
@@ -79,6 +79,42 @@ func TestConfigParse(t *testing.T) {
 				},
 			},
 		},
+		{
+			conf: `
+        package = "main"
+
+        type "SomeEnum" {
+          include_deprecated = true
+        }
+      `,
+			expected: Config{
+				Package: "main",
+				Type: map[string]TypeConfig{
+					"SomeEnum": TypeConfig{
+						IncludeDeprecated: true,
+					},
+				},
+			},
+		},
+		{
+			conf: `
+        package = "main"
+
+        scalar "DateTime" {
+          go_type = "time.Time"
+          import_path = "time"
+        }
+      `,
+			expected: Config{
+				Package: "main",
+				Scalar: map[string]ScalarConfig{
+					"DateTime": ScalarConfig{
+						GoType:     "time.Time",
+						ImportPath: "time",
+					},
+				},
+			},
+		},
 	}
 
 	for _, test := range tests {
@@ -0,0 +1,87 @@
+package config
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	graphql "github.com/neelance/graphql-go"
+)
+
+// Validate checks that the types, fields and scalars named in the config exist
+// in the schema. Generation looks them up by name, so a misspelt name would
+// otherwise be ignored. The schema may map scalars with @goType directives like
+// the one generated from.
+func Validate(schema string, conf Config) error {
+	schema, _, err := GoTypeDirectives(schema)
+	if err != nil {
+		return err
+	}
+	sch, err := graphql.ParseSchema(schema, nil)
+	if err != nil {
+		return err
+	}
+
+	kinds := map[string]string{}
+	fields := map[string]map[string]bool{}
+	for _, tp := range sch.Inspect().Types() {
+		name := *tp.Name()
+		kinds[name] = tp.Kind()
+		fields[name] = map[string]bool{}
+		if tp.Fields(&struct{ IncludeDeprecated bool }{true}) != nil {
+			for _, fp := range *tp.Fields(&struct{ IncludeDeprecated bool }{true}) {
+				fields[name][fp.Name()] = true
+			}
+		}
+		if tp.InputFields() != nil {
+			for _, ip := range *tp.InputFields() {
+				fields[name][ip.Name()] = true
+			}
+		}
+	}
+
+	typeNames := make([]string, 0, len(conf.Type))
+	for name := range conf.Type {
+		typeNames = append(typeNames, name)
+	}
+	sort.Strings(typeNames)
+
+	var problems []string
+	for _, name := range typeNames {
+		if _, ok := kinds[name]; !ok {
+			problems = append(problems, fmt.Sprintf("unknown type %s", name))
+			continue
+		}
+		fieldNames := make([]string, 0, len(conf.Type[name].Field))
+		for fieldName := range conf.Type[name].Field {
+			fieldNames = append(fieldNames, fieldName)
+		}
+		sort.Strings(fieldNames)
+		for _, fieldName := range fieldNames {
+			if !fields[name][fieldName] {
+				problems = append(problems, fmt.Sprintf("unknown field %s of type %s", fieldName, name))
+			}
+		}
+		if stringField := conf.Type[name].StringField; stringField != "" && !fields[name][stringField] {
+			problems = append(problems, fmt.Sprintf("unknown string_field %s of type %s", stringField, name))
+		}
+	}
+
+	scalarNames := make([]string, 0, len(conf.Scalar))
+	for name := range conf.Scalar {
+		scalarNames = append(scalarNames, name)
+	}
+	sort.Strings(scalarNames)
+	for _, name := range scalarNames {
+		if kind, ok := kinds[name]; !ok {
+			problems = append(problems, fmt.Sprintf("unknown scalar %s", name))
+		} else if kind != "SCALAR" {
+			problems = append(problems, fmt.Sprintf("scalar %s is of kind %s", name, kind))
+		}
+	}
+
+	if len(problems) > 0 {
+		return fmt.Errorf("config does not match the schema: %s", strings.Join(problems, "; "))
+	}
+	return nil
+}
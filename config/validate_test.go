@@ -0,0 +1,66 @@
+package config
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestValidate(t *testing.T) {
+	schema := `
+		scalar Time
+
+		type Query {
+			human(id: ID!): Human
+		}
+
+		type Human {
+			name: String!
+			born: Time
+		}
+
+		input HumanInput {
+			name: String!
+		}
+	`
+
+	valid := Config{
+		Type: map[string]TypeConfig{
+			"Human":      {Field: map[string]FieldConfig{"name": {GoName: "FullName"}}, StringField: "name"},
+			"HumanInput": {Field: map[string]FieldConfig{"name": {}}},
+		},
+		Scalar: map[string]ScalarConfig{"Time": {GoType: "time.Time", ImportPath: "time"}},
+	}
+	if err := Validate(schema, valid); err != nil {
+		t.Errorf("Expected the config to be valid, got %v", err)
+	}
+
+	invalid := Config{
+		Type: map[string]TypeConfig{
+			"Humann": {},
+			"Human":  {Field: map[string]FieldConfig{"nam": {}}, StringField: "nick"},
+		},
+		Scalar: map[string]ScalarConfig{"Date": {GoType: "time.Time"}, "Human": {GoType: "string"}},
+	}
+	err := Validate(schema, invalid)
+	if err == nil {
+		t.Fatal("Expected the unknown names to be reported")
+	}
+	for _, expected := range []string{"unknown type Humann", "unknown field nam of type Human", "unknown string_field nick of type Human", "unknown scalar Date", "scalar Human is of kind OBJECT"} {
+		if !strings.Contains(err.Error(), expected) {
+			t.Errorf("Expected %q in %v", expected, err)
+		}
+	}
+
+	if err := Validate("type {", Config{}); err == nil {
+		t.Error("Expected the schema error")
+	}
+
+	// Scalars mapped in the schema are validated like the generator sees them
+	mapped := strings.Replace(schema, "scalar Time", `scalar Time @goType(name: "time.Time", import: "time")`, 1)
+	if err := Validate(mapped, valid); err != nil {
+		t.Errorf("Expected the config to be valid with @goType, got %v", err)
+	}
+	if err := Validate(strings.Replace(mapped, `name: "time.Time", `, "", 1), valid); err == nil || !strings.Contains(err.Error(), "@goType") {
+		t.Errorf("Expected the error of the @goType directive, got %v", err)
+	}
+}
@@ -0,0 +1,25 @@
+package config
+
+import (
+	"io/ioutil"
+
+	yaml "gopkg.in/yaml.v2"
+)
+
+// LoadYAML loads codegen config from a YAML file
+func LoadYAML(path string) (Config, error) {
+	fileBytes, err := ioutil.ReadFile(path)
+	if err != nil {
+		return Config{}, err
+	}
+	return ParseYAML(string(fileBytes))
+}
+
+// ParseYAML parses codegen config in YAML format
+func ParseYAML(config string) (cfg Config, err error) {
+	if err = yaml.Unmarshal([]byte(config), &cfg); err != nil {
+		return
+	}
+	normaliseTemplates(&cfg)
+	return
+}
@@ -0,0 +1,95 @@
+package config
+
+import (
+	"io/ioutil"
+	"path"
+	"reflect"
+	"testing"
+)
+
+const yamlConfig = `
+package: example
+
+scalar:
+  DateTime:
+    go_type: time.Time
+    import_path: time
+
+type:
+  User:
+    with_context: true
+    field:
+      friends:
+        imports: ["\"fmt\""]
+        dataloader: true
+        memoize: true
+        template:
+          http_resolver:
+            url: fmt.Sprintf("https://example.com/users/%s/friends", r.User.ID)
+            fields:
+              - field_name: friend_ids
+                field_type: "[]string"
+      avatar:
+        template:
+          custom:
+`
+
+func TestLoadYAML(t *testing.T) {
+	file := path.Join(t.TempDir(), "config.yaml")
+	if err := ioutil.WriteFile(file, []byte(yamlConfig), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	cfg, err := LoadYAML(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := Config{
+		Package: "example",
+		Scalar: map[string]ScalarConfig{
+			"DateTime": ScalarConfig{
+				GoType:     "time.Time",
+				ImportPath: "time",
+			},
+		},
+		Type: map[string]TypeConfig{
+			"User": TypeConfig{
+				WithContext: true,
+				Field: map[string]FieldConfig{
+					"friends": FieldConfig{
+						Imports:    []string{"\"fmt\""},
+						Dataloader: true,
+						Memoize:    true,
+						Template: map[string]map[string]interface{}{
+							"http_resolver": map[string]interface{}{
+								"url": "fmt.Sprintf(\"https://example.com/users/%s/friends\", r.User.ID)",
+								"fields": []interface{}{
+									map[string]interface{}{
+										"field_name": "friend_ids",
+										"field_type": "[]string",
+									},
+								},
+							},
+						},
+					},
+					"avatar": FieldConfig{
+						Template: map[string]map[string]interface{}{
+							"custom": nil,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	if !reflect.DeepEqual(cfg, expected) {
+		t.Errorf("Expected\n%+v\nto equal\n%+v", cfg, expected)
+	}
+}
+
+func TestLoadYAMLMissingFile(t *testing.T) {
+	if _, err := LoadYAML(path.Join(t.TempDir(), "missing.yaml")); err == nil {
+		t.Error("Expected an error for a missing file")
+	}
+}
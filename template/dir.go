@@ -0,0 +1,25 @@
+package template
+
+import (
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+)
+
+// assetFunc reads a template file by its path relative to the template root
+type assetFunc func(name string) ([]byte, error)
+
+// dirAsset reads template files from dir, which has the same layout as the
+// built-in templates
+func dirAsset(dir string) assetFunc {
+	return func(name string) ([]byte, error) {
+		return ioutil.ReadFile(filepath.Join(dir, filepath.FromSlash(name)))
+	}
+}
+
+func notFoundError(kind string, dir string, templateName string) error {
+	if dir == "" {
+		return fmt.Errorf("unknown %s template %q", kind, templateName)
+	}
+	return fmt.Errorf("%s template %q not found in %s or the built-in templates", kind, templateName, dir)
+}
@@ -0,0 +1,38 @@
+package template
+
+import (
+	"embed"
+	"path"
+	"strings"
+)
+
+// builtinTemplates holds the built-in templates, compiled into the binary so
+// they are found wherever the package is used from
+//
+//go:embed type property partials
+var builtinTemplates embed.FS
+
+// Asset returns the built-in template file with the given path, such as
+// "type/default/type.tmpl"
+func Asset(name string) ([]byte, error) {
+	return builtinTemplates.ReadFile(name)
+}
+
+// Partials returns the built-in partial templates, {{define}} blocks like
+// "arguments" shared by the type templates. They are parsed along with every
+// template, which can still define a block of the same name instead.
+func Partials() (string, error) {
+	entries, err := builtinTemplates.ReadDir("partials")
+	if err != nil {
+		return "", err
+	}
+	partials := &strings.Builder{}
+	for _, entry := range entries {
+		b, err := builtinTemplates.ReadFile(path.Join("partials", entry.Name()))
+		if err != nil {
+			return "", err
+		}
+		partials.Write(b)
+	}
+	return partials.String(), nil
+}
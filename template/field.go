@@ -1,14 +1,12 @@
 package template
 
 import (
-	"errors"
 	"path"
+	"sync"
 
 	"github.com/hashicorp/hcl"
 )
 
-//go:generate go-bindata -pkg template -ignore=\.go  -o assets.go ./...
-
 type PropertyTemplateConfig struct {
 	Field   string
 	Method  string
@@ -19,15 +17,21 @@ type PropertyTemplate struct {
 	Config         PropertyTemplateConfig
 	FieldTemplate  string
 	MethodTemplate string
+	// Dir is the template directory the template was loaded from, empty for
+	// the built-in templates
+	Dir string
 }
 
+// builtinPropertyTemplates holds the built-in property templates by name
+var builtinPropertyTemplates sync.Map
+
 func parseConfig(str string) (config PropertyTemplateConfig, err error) {
 	err = hcl.Decode(&config, str)
 	return
 }
 
-func loadStoredTemplate(template string) (*PropertyTemplate, bool, error) {
-	cfgStr, err := Asset(template + "/config.hcl")
+func loadStoredTemplate(asset assetFunc, template string) (*PropertyTemplate, bool, error) {
+	cfgStr, err := asset(template + "/config.hcl")
 	if err != nil {
 		return nil, false, nil
 	}
@@ -37,12 +41,12 @@ func loadStoredTemplate(template string) (*PropertyTemplate, bool, error) {
 		return nil, false, err
 	}
 
-	fieldTemplateString, err := Asset(path.Join(template, cfg.Field))
+	fieldTemplateString, err := asset(path.Join(template, cfg.Field))
 	if err != nil {
 		return nil, false, err
 	}
 
-	methodTemplateString, err := Asset(path.Join(template, cfg.Method))
+	methodTemplateString, err := asset(path.Join(template, cfg.Method))
 	if err != nil {
 		return nil, false, err
 	}
@@ -54,11 +58,37 @@ func loadStoredTemplate(template string) (*PropertyTemplate, bool, error) {
 	}, true, nil
 }
 
+// GetPropertyTemplate returns the built-in property template with the given name
 func GetPropertyTemplate(templateName string) (template *PropertyTemplate, err error) {
-	template, ok, err := loadStoredTemplate("property/" + templateName)
-	// if ok false read file from the directory
+	return GetPropertyTemplateFromDir("", templateName)
+}
+
+// GetPropertyTemplateFromDir returns the property template with the given
+// name from dir, or the built-in one when dir is empty or has no such template
+func GetPropertyTemplateFromDir(dir string, templateName string) (template *PropertyTemplate, err error) {
+	if dir != "" {
+		template, ok, err := loadStoredTemplate(dirAsset(dir), "property/"+templateName)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			template.Dir = dir
+			return template, nil
+		}
+	}
+
+	if cached, ok := builtinPropertyTemplates.Load(templateName); ok {
+		template := *cached.(*PropertyTemplate)
+		return &template, nil
+	}
+	template, ok, err := loadStoredTemplate(Asset, "property/"+templateName)
+	if err != nil {
+		return nil, err
+	}
 	if !ok {
-		return nil, errors.New("Fail")
+		return nil, notFoundError("property", dir, templateName)
 	}
-	return
+	builtinPropertyTemplates.Store(templateName, template)
+	copied := *template
+	return &copied, nil
 }
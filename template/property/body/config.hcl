@@ -0,0 +1,2 @@
+field = "field.tmpl"
+method = "method.tmpl"
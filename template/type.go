@@ -1,14 +1,12 @@
 package template
 
 import (
-	"errors"
 	"path"
+	"sync"
 
 	"github.com/hashicorp/hcl"
 )
 
-//go:generate go-bindata -pkg template -ignore=\.go  -o assets.go ./...
-
 type TypeTemplateConfig struct {
 	Type    string
 	Imports []string
@@ -17,15 +15,22 @@ type TypeTemplateConfig struct {
 type TypeTemplate struct {
 	Config       TypeTemplateConfig
 	TypeTemplate string
+	// Dir is the template directory the template was loaded from, empty for
+	// the built-in templates
+	Dir string
 }
 
+// builtinTypeTemplates holds the built-in type templates by name, as they
+// cannot change their config is decoded once
+var builtinTypeTemplates sync.Map
+
 func parseTypeConfig(str string) (config TypeTemplateConfig, err error) {
 	err = hcl.Decode(&config, str)
 	return
 }
 
-func loadStoredTypeTemplate(template string) (*TypeTemplate, bool, error) {
-	cfgStr, err := Asset(template + "/config.hcl")
+func loadStoredTypeTemplate(asset assetFunc, template string) (*TypeTemplate, bool, error) {
+	cfgStr, err := asset(template + "/config.hcl")
 	if err != nil {
 		return nil, false, nil
 	}
@@ -35,7 +40,7 @@ func loadStoredTypeTemplate(template string) (*TypeTemplate, bool, error) {
 		return nil, false, err
 	}
 
-	templateString, err := Asset(path.Join(template, cfg.Type))
+	templateString, err := asset(path.Join(template, cfg.Type))
 	if err != nil {
 		return nil, false, err
 	}
@@ -46,11 +51,37 @@ func loadStoredTypeTemplate(template string) (*TypeTemplate, bool, error) {
 	}, true, nil
 }
 
+// GetTypeTemplate returns the built-in type template with the given name
 func GetTypeTemplate(templateName string) (template *TypeTemplate, err error) {
-	template, ok, err := loadStoredTypeTemplate("type/" + templateName)
-	// if ok false read file from the directory
+	return GetTypeTemplateFromDir("", templateName)
+}
+
+// GetTypeTemplateFromDir returns the type template with the given name from
+// dir, or the built-in one when dir is empty or has no such template
+func GetTypeTemplateFromDir(dir string, templateName string) (template *TypeTemplate, err error) {
+	if dir != "" {
+		template, ok, err := loadStoredTypeTemplate(dirAsset(dir), "type/"+templateName)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			template.Dir = dir
+			return template, nil
+		}
+	}
+
+	if cached, ok := builtinTypeTemplates.Load(templateName); ok {
+		template := *cached.(*TypeTemplate)
+		return &template, nil
+	}
+	template, ok, err := loadStoredTypeTemplate(Asset, "type/"+templateName)
+	if err != nil {
+		return nil, err
+	}
 	if !ok {
-		return nil, errors.New("Fail")
+		return nil, notFoundError("type", dir, templateName)
 	}
-	return
+	builtinTypeTemplates.Store(templateName, template)
+	copied := *template
+	return &copied, nil
 }
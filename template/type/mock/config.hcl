@@ -0,0 +1 @@
+type = "type.tmpl"